@@ -0,0 +1,177 @@
+package DegenTrailNFT
+
+// This file is not generated. It implements the packed representation of DegenTrailStats described by the
+// masks on the DegenTrailNFT contract, and reads the tokens held by an owner.
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Widths (in bits) of the fields of packed stats. From least significant to most significant, packed stats
+// hold recovery, repair, fight, speed and kind.
+const (
+	StatBits uint = 54
+	KindBits uint = 40
+)
+
+// Offsets of the fields of packed stats from the least significant bit.
+const (
+	RecoveryOffset uint = 0
+	RepairOffset   uint = RecoveryOffset + StatBits
+	FightOffset    uint = RepairOffset + StatBits
+	SpeedOffset    uint = FightOffset + StatBits
+	KindOffset     uint = SpeedOffset + StatBits
+)
+
+// Largest values that the fields of packed stats can hold.
+const (
+	MaxStat uint64 = 1<<StatBits - 1
+	MaxKind uint64 = 1<<KindBits - 1
+)
+
+// DegenTrailStats mirrors the DegenTrailStats struct from src/data.sol, restricted to the ranges of the
+// packed layout.
+//
+// The mask constants on the contract do not match this layout: recoveryMask is declared as 2 ^ 55 - 1, which
+// Solidity evaluates as 2 XOR 54, and the other masks are shifted from it. Use Mask to get the masks that the
+// layout describes.
+type DegenTrailStats struct {
+	Kind     uint64
+	Speed    uint64
+	Fight    uint64
+	Repair   uint64
+	Recovery uint64
+}
+
+// StatOverflowError is returned when a stat does not fit in its field of the packed layout.
+type StatOverflowError struct {
+	Stat  string
+	Value *big.Int
+	Bits  uint
+}
+
+func (e *StatOverflowError) Error() string {
+	return fmt.Sprintf("%s stat %s does not fit in %d bits", e.Stat, e.Value.String(), e.Bits)
+}
+
+// Mask returns the mask for the field of the packed layout that holds the given stat ("kind", "speed",
+// "fight", "repair" or "recovery"). It returns nil for any other stat.
+func Mask(stat string) *big.Int {
+	bits, offset := StatBits, uint(0)
+	switch stat {
+	case "kind":
+		bits, offset = KindBits, KindOffset
+	case "speed":
+		offset = SpeedOffset
+	case "fight":
+		offset = FightOffset
+	case "repair":
+		offset = RepairOffset
+	case "recovery":
+		offset = RecoveryOffset
+	default:
+		return nil
+	}
+
+	mask := new(big.Int).Lsh(big.NewInt(1), bits)
+	mask.Sub(mask, big.NewInt(1))
+	return mask.Lsh(mask, offset)
+}
+
+// Decode unpacks stats from the packed layout. Bits of packed beyond the most significant 256 are ignored.
+func Decode(packed *big.Int) DegenTrailStats {
+	field := func(offset, bits uint) uint64 {
+		value := new(big.Int).Rsh(packed, offset)
+		mask := new(big.Int).Lsh(big.NewInt(1), bits)
+		mask.Sub(mask, big.NewInt(1))
+		return value.And(value, mask).Uint64()
+	}
+
+	return DegenTrailStats{
+		Kind:     field(KindOffset, KindBits),
+		Speed:    field(SpeedOffset, StatBits),
+		Fight:    field(FightOffset, StatBits),
+		Repair:   field(RepairOffset, StatBits),
+		Recovery: field(RecoveryOffset, StatBits),
+	}
+}
+
+// Encode packs the stats into the packed layout. It returns a *StatOverflowError if any of the stats does not
+// fit in its field.
+func (stats DegenTrailStats) Encode() (*big.Int, error) {
+	fields := []struct {
+		stat   string
+		value  uint64
+		bits   uint
+		offset uint
+	}{
+		{"kind", stats.Kind, KindBits, KindOffset},
+		{"speed", stats.Speed, StatBits, SpeedOffset},
+		{"fight", stats.Fight, StatBits, FightOffset},
+		{"repair", stats.Repair, StatBits, RepairOffset},
+		{"recovery", stats.Recovery, StatBits, RecoveryOffset},
+	}
+
+	packed := new(big.Int)
+	for _, field := range fields {
+		if field.value>>field.bits != 0 {
+			return nil, &StatOverflowError{Stat: field.stat, Value: new(big.Int).SetUint64(field.value), Bits: field.bits}
+		}
+		packed.Or(packed, new(big.Int).Lsh(new(big.Int).SetUint64(field.value), field.offset))
+	}
+	return packed, nil
+}
+
+// ReadStats reads the stats of the given token from the stats mapping on a DegenTrailNFT contract. It returns
+// a *StatOverflowError if the stored stats do not fit in the packed layout.
+func ReadStats(caller *DegenTrailNFTCaller, opts *bind.CallOpts, tokenID *big.Int) (DegenTrailStats, error) {
+	raw, rawErr := caller.Stats(opts, tokenID)
+	if rawErr != nil {
+		return DegenTrailStats{}, rawErr
+	}
+
+	var stats DegenTrailStats
+	fields := []struct {
+		stat  string
+		value *big.Int
+		bits  uint
+		out   *uint64
+	}{
+		{"kind", raw.Kind, KindBits, &stats.Kind},
+		{"speed", raw.Speed, StatBits, &stats.Speed},
+		{"fight", raw.Fight, StatBits, &stats.Fight},
+		{"repair", raw.Repair, StatBits, &stats.Repair},
+		{"recovery", raw.Recovery, StatBits, &stats.Recovery},
+	}
+
+	for _, field := range fields {
+		if field.value.BitLen() > int(field.bits) {
+			return DegenTrailStats{}, &StatOverflowError{Stat: field.stat, Value: field.value, Bits: field.bits}
+		}
+		*field.out = field.value.Uint64()
+	}
+	return stats, nil
+}
+
+// ReadOwnerTokens lists the IDs of the tokens held by owner, using the ERC721Enumerable methods on a
+// DegenTrailNFT contract.
+func ReadOwnerTokens(caller *DegenTrailNFTCaller, opts *bind.CallOpts, owner common.Address) ([]*big.Int, error) {
+	balance, balanceErr := caller.BalanceOf(opts, owner)
+	if balanceErr != nil {
+		return nil, balanceErr
+	}
+
+	tokenIDs := make([]*big.Int, 0, balance.Int64())
+	for i := int64(0); i < balance.Int64(); i++ {
+		tokenID, tokenIDErr := caller.TokenOfOwnerByIndex(opts, owner, big.NewInt(i))
+		if tokenIDErr != nil {
+			return nil, tokenIDErr
+		}
+		tokenIDs = append(tokenIDs, tokenID)
+	}
+	return tokenIDs, nil
+}
@@ -0,0 +1,70 @@
+package DegenTrailNFT
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestStatsRoundTrip(t *testing.T) {
+	cases := []DegenTrailStats{
+		{},
+		{Kind: 1, Speed: 2, Fight: 3, Repair: 4, Recovery: 5},
+		{Kind: MaxKind, Speed: MaxStat, Fight: MaxStat, Repair: MaxStat, Recovery: MaxStat},
+		{Kind: 0xABCDE, Speed: 1 << 53, Fight: 0, Repair: MaxStat, Recovery: 1},
+	}
+	for _, stats := range cases {
+		packed, encodeErr := stats.Encode()
+		if encodeErr != nil {
+			t.Fatalf("could not encode %+v: %s", stats, encodeErr.Error())
+		}
+		if packed.BitLen() > 256 {
+			t.Errorf("packed stats %+v do not fit in 256 bits", stats)
+		}
+		if decoded := Decode(packed); decoded != stats {
+			t.Errorf("decoding the encoding of %+v returned %+v", stats, decoded)
+		}
+	}
+
+	allOnes := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	full := DegenTrailStats{Kind: MaxKind, Speed: MaxStat, Fight: MaxStat, Repair: MaxStat, Recovery: MaxStat}
+	if packed, _ := full.Encode(); packed.Cmp(allOnes) != 0 {
+		t.Errorf("expected maximal stats to pack to 2^256 - 1, got %s", packed.Text(16))
+	}
+}
+
+func TestStatsLayout(t *testing.T) {
+	stats := Decode(new(big.Int).Lsh(big.NewInt(1), KindOffset))
+	if stats != (DegenTrailStats{Kind: 1}) {
+		t.Errorf("expected bit %d to be the least significant bit of kind, got %+v", KindOffset, stats)
+	}
+	stats = Decode(new(big.Int).Lsh(big.NewInt(1), RepairOffset-1))
+	if stats != (DegenTrailStats{Recovery: 1 << 53}) {
+		t.Errorf("expected bit %d to be the most significant bit of recovery, got %+v", RepairOffset-1, stats)
+	}
+
+	union := new(big.Int)
+	for _, stat := range []string{"kind", "speed", "fight", "repair", "recovery"} {
+		mask := Mask(stat)
+		if new(big.Int).And(union, mask).Sign() != 0 {
+			t.Errorf("mask for %s overlaps the masks before it", stat)
+		}
+		union.Or(union, mask)
+	}
+	if union.BitLen() != 256 || union.Cmp(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))) != 0 {
+		t.Errorf("expected masks to cover all 256 bits, got %s", union.Text(16))
+	}
+	if Mask("wisdom") != nil {
+		t.Errorf("expected no mask for an unknown stat")
+	}
+}
+
+func TestStatsOverflow(t *testing.T) {
+	var overflow *StatOverflowError
+	if _, err := (DegenTrailStats{Kind: MaxKind + 1}).Encode(); !errors.As(err, &overflow) || overflow.Stat != "kind" {
+		t.Errorf("expected an overflow on kind, got %v", err)
+	}
+	if _, err := (DegenTrailStats{Fight: MaxStat + 1}).Encode(); !errors.As(err, &overflow) || overflow.Stat != "fight" {
+		t.Errorf("expected an overflow on fight, got %v", err)
+	}
+}
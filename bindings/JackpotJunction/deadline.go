@@ -0,0 +1,135 @@
+package JackpotJunction
+
+// This file is not generated. It helps players accept the outcome of a roll before their deadline expires, by
+// estimating the block in which an accept transaction sent now would be included.
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Default number of recent blocks that block times are averaged over.
+const DefaultBlockTimeSamples uint64 = 20
+
+var ErrNoRollToAccept error = errors.New("there is no roll to accept: the player has not rolled, or has already accepted their last roll")
+
+// HeaderBackend is the subset of the Ethereum JSON-RPC API that is needed to inspect recent blocks. It is
+// satisfied by *ethclient.Client.
+type HeaderBackend interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// AcceptWindow describes the blocks in which a player may accept the outcome of their last roll, as of the
+// Head block.
+type AcceptWindow struct {
+	Head          *types.Header
+	LastRollBlock uint64
+	BlocksToAct   uint64
+	// Average time between recent blocks. It is 0 if recent blocks all have the same timestamp, as on
+	// development chains which mine a block per transaction.
+	BlockTime time.Duration
+	// Whether accept would sample the outcome from the improved distribution.
+	Bonus bool
+	// The outcome that accept would award at the Head block. Its Err is set if the outcome cannot be
+	// previewed at the Head block, e.g. because the player rolled in that block.
+	Preview Result[OutcomeResult]
+}
+
+// Deadline returns the last block in which accept succeeds.
+func (window AcceptWindow) Deadline() uint64 {
+	return window.LastRollBlock + window.BlocksToAct
+}
+
+// ExpectedInclusionBlock estimates the block that a transaction will be included in if it reaches the
+// network submissionDelay after now. It assumes that blocks keep being produced every BlockTime after the
+// Head block and that the transaction is included in the first block produced after it is received.
+func (window AcceptWindow) ExpectedInclusionBlock(now time.Time, submissionDelay time.Duration) uint64 {
+	next := window.Head.Number.Uint64() + 1
+	if window.BlockTime <= 0 {
+		return next
+	}
+
+	elapsed := now.Add(submissionDelay).Sub(time.Unix(int64(window.Head.Time), 0))
+	if elapsed < 0 {
+		return next
+	}
+	return next + uint64(elapsed/window.BlockTime)
+}
+
+// EstimateBlockTime returns the average time between the last sampleBlocks blocks up to head.
+func EstimateBlockTime(ctx context.Context, backend HeaderBackend, head *types.Header, sampleBlocks uint64) (time.Duration, error) {
+	if sampleBlocks == 0 {
+		sampleBlocks = DefaultBlockTimeSamples
+	}
+	if head.Number.Uint64() < sampleBlocks {
+		sampleBlocks = head.Number.Uint64()
+	}
+	if sampleBlocks == 0 {
+		return 0, nil
+	}
+
+	earlier, earlierErr := backend.HeaderByNumber(ctx, new(big.Int).Sub(head.Number, new(big.Int).SetUint64(sampleBlocks)))
+	if earlierErr != nil {
+		return 0, earlierErr
+	}
+	if head.Time <= earlier.Time {
+		return 0, nil
+	}
+
+	return time.Duration(head.Time-earlier.Time) * time.Second / time.Duration(sampleBlocks), nil
+}
+
+// ReadAcceptWindow reads the state of the given player's last roll at the current block, previews the
+// outcome that accept would award them, and estimates the block time from the last sampleBlocks blocks. It
+// returns ErrNoRollToAccept if the player has no roll in progress.
+func ReadAcceptWindow(ctx context.Context, headers HeaderBackend, calls BatchBackend, contractAddress, player common.Address, sampleBlocks uint64) (AcceptWindow, error) {
+	head, headErr := headers.HeaderByNumber(ctx, nil)
+	if headErr != nil {
+		return AcceptWindow{}, headErr
+	}
+
+	batch, batchErr := NewBatch(contractAddress)
+	if batchErr != nil {
+		return AcceptWindow{}, batchErr
+	}
+	lastRollBlock := batch.LastRollBlock(player)
+	blocksToAct := batch.BlocksToAct()
+	bonus := batch.HasBonus(player)
+	unmodifiedOutcome := batch.Outcome(player, false)
+	improvedOutcome := batch.Outcome(player, true)
+	executeErr := batch.Execute(ctx, calls, head.Number, 0)
+	if executeErr != nil {
+		return AcceptWindow{}, executeErr
+	}
+	for _, err := range []error{lastRollBlock.Err, blocksToAct.Err, bonus.Err} {
+		if err != nil {
+			return AcceptWindow{}, err
+		}
+	}
+	if lastRollBlock.Value.Sign() == 0 {
+		return AcceptWindow{}, ErrNoRollToAccept
+	}
+
+	blockTime, blockTimeErr := EstimateBlockTime(ctx, headers, head, sampleBlocks)
+	if blockTimeErr != nil {
+		return AcceptWindow{}, blockTimeErr
+	}
+
+	window := AcceptWindow{
+		Head:          head,
+		LastRollBlock: lastRollBlock.Value.Uint64(),
+		BlocksToAct:   blocksToAct.Value.Uint64(),
+		BlockTime:     blockTime,
+		Bonus:         bonus.Value,
+		Preview:       *unmodifiedOutcome,
+	}
+	if window.Bonus {
+		window.Preview = *improvedOutcome
+	}
+	return window, nil
+}
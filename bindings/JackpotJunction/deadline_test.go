@@ -0,0 +1,76 @@
+package JackpotJunction
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Serves headers for blocks produced every blockTime seconds since the genesis timestamp.
+type headerBackend struct {
+	genesis   uint64
+	blockTime uint64
+}
+
+func (b headerBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: new(big.Int).Set(number), Time: b.genesis + number.Uint64()*b.blockTime}, nil
+}
+
+func TestEstimateBlockTime(t *testing.T) {
+	backend := headerBackend{genesis: 1700000000, blockTime: 2}
+	head, _ := backend.HeaderByNumber(context.Background(), big.NewInt(1000))
+
+	blockTime, blockTimeErr := EstimateBlockTime(context.Background(), backend, head, 0)
+	if blockTimeErr != nil {
+		t.Fatalf("unexpected error: %s", blockTimeErr.Error())
+	}
+	if blockTime != 2*time.Second {
+		t.Errorf("expected a block time of 2s, got %s", blockTime.String())
+	}
+
+	genesis, _ := backend.HeaderByNumber(context.Background(), big.NewInt(0))
+	genesisBlockTime, genesisErr := EstimateBlockTime(context.Background(), backend, genesis, 0)
+	if genesisErr != nil || genesisBlockTime != 0 {
+		t.Errorf("expected a block time of 0 at genesis, got %s (error: %v)", genesisBlockTime.String(), genesisErr)
+	}
+}
+
+func TestExpectedInclusionBlock(t *testing.T) {
+	headTime := time.Unix(1700000000, 0)
+	window := AcceptWindow{
+		Head:          &types.Header{Number: big.NewInt(100), Time: uint64(headTime.Unix())},
+		LastRollBlock: 95,
+		BlocksToAct:   6,
+		BlockTime:     2 * time.Second,
+	}
+	if window.Deadline() != 101 {
+		t.Errorf("expected deadline 101, got %d", window.Deadline())
+	}
+
+	cases := []struct {
+		sinceHead       time.Duration
+		submissionDelay time.Duration
+		expected        uint64
+	}{
+		{0, 0, 101},
+		{500 * time.Millisecond, time.Second, 101},
+		{time.Second, time.Second, 102},
+		{3 * time.Second, 2 * time.Second, 103},
+		// A clock behind the head block should not move the estimate before the next block.
+		{-5 * time.Second, 0, 101},
+	}
+	for _, c := range cases {
+		inclusionBlock := window.ExpectedInclusionBlock(headTime.Add(c.sinceHead), c.submissionDelay)
+		if inclusionBlock != c.expected {
+			t.Errorf("%s after the head block with a submission delay of %s: expected block %d, got %d", c.sinceHead.String(), c.submissionDelay.String(), c.expected, inclusionBlock)
+		}
+	}
+
+	window.BlockTime = 0
+	if inclusionBlock := window.ExpectedInclusionBlock(headTime.Add(time.Minute), time.Second); inclusionBlock != 101 {
+		t.Errorf("expected block 101 without a block time, got %d", inclusionBlock)
+	}
+}
@@ -0,0 +1,252 @@
+package JackpotJunction
+
+// This file is not generated. It decodes the revert data returned by the JSON-RPC API when calls to the
+// JackpotJunction contract fail into typed Go errors.
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Raised when a player tries to act more than BlocksToAct blocks after they last rolled.
+type DeadlineExceededError struct{}
+
+func (e *DeadlineExceededError) Error() string {
+	return "DeadlineExceeded: more than BlocksToAct blocks have elapsed since your last roll -- you must roll again (at the full CostToRoll)"
+}
+
+// Raised when a player tries to equip or unequip items while they are in the middle of a roll.
+type RollInProgressError struct{}
+
+func (e *RollInProgressError) Error() string {
+	return "RollInProgress: you cannot equip or unequip items while you are rolling -- accept your outcome or wait until more than BlocksToAct blocks have elapsed since your last roll"
+}
+
+// Raised when a player tries to view or accept the outcome of a roll in the same block that they rolled in.
+type WaitForTickError struct{}
+
+func (e *WaitForTickError) Error() string {
+	return "WaitForTick: you must wait one more block after rolling before calling outcome or accept"
+}
+
+// Raised when a player sends less than CostToRoll (or CostToReroll) with their roll.
+type InsufficientValueError struct{}
+
+func (e *InsufficientValueError) Error() string {
+	return "InsufficientValue: the value sent with the transaction is less than CostToRoll (or CostToReroll if you are rerolling) -- check the costs and pass a larger --value"
+}
+
+// Raised when a player tries to equip an item which does not correspond to a wagon slot.
+type InvalidItemError struct {
+	PoolID *big.Int
+}
+
+func (e *InvalidItemError) Error() string {
+	return fmt.Sprintf("InvalidItem(%s): pool ID %s is not a valid item for this action", e.PoolID.String(), e.PoolID.String())
+}
+
+// Raised when a player tries to craft more outputs than their balance of the input item allows.
+type InsufficientItemsError struct {
+	PoolID *big.Int
+}
+
+func (e *InsufficientItemsError) Error() string {
+	return fmt.Sprintf("InsufficientItems(%s): you need 2 items of pool ID %s for every output you craft -- lower --num-outputs or collect more items", e.PoolID.String(), e.PoolID.String())
+}
+
+// Raised when a nonReentrant method on the contract is reentered.
+type ReentrantCallError struct{}
+
+func (e *ReentrantCallError) Error() string {
+	return "ReentrancyGuardReentrantCall: the contract was called reentrantly"
+}
+
+// Raised by ERC1155 transfers and burns when the sender does not hold enough of a token.
+type ERC1155InsufficientBalanceError struct {
+	Sender  common.Address
+	Balance *big.Int
+	Needed  *big.Int
+	TokenID *big.Int
+}
+
+func (e *ERC1155InsufficientBalanceError) Error() string {
+	return fmt.Sprintf("ERC1155InsufficientBalance: %s holds %s of pool ID %s but %s are needed", e.Sender.Hex(), e.Balance.String(), e.TokenID.String(), e.Needed.String())
+}
+
+// Raised by ERC1155 transfers from an invalid (zero) sender.
+type ERC1155InvalidSenderError struct {
+	Sender common.Address
+}
+
+func (e *ERC1155InvalidSenderError) Error() string {
+	return fmt.Sprintf("ERC1155InvalidSender: %s cannot send tokens", e.Sender.Hex())
+}
+
+// Raised by ERC1155 transfers to a receiver which is the zero address or which does not accept ERC1155 tokens.
+type ERC1155InvalidReceiverError struct {
+	Receiver common.Address
+}
+
+func (e *ERC1155InvalidReceiverError) Error() string {
+	return fmt.Sprintf("ERC1155InvalidReceiver: %s cannot receive ERC1155 tokens -- if it is a contract, it must implement onERC1155Received", e.Receiver.Hex())
+}
+
+// Raised when an operator transfers tokens on behalf of an owner who has not approved them.
+type ERC1155MissingApprovalForAllError struct {
+	Operator common.Address
+	Owner    common.Address
+}
+
+func (e *ERC1155MissingApprovalForAllError) Error() string {
+	return fmt.Sprintf("ERC1155MissingApprovalForAll: %s has not approved %s to transfer their tokens -- call setApprovalForAll from the owner's account", e.Owner.Hex(), e.Operator.Hex())
+}
+
+// Raised when an invalid approver calls setApprovalForAll.
+type ERC1155InvalidApproverError struct {
+	Approver common.Address
+}
+
+func (e *ERC1155InvalidApproverError) Error() string {
+	return fmt.Sprintf("ERC1155InvalidApprover: %s cannot approve operators", e.Approver.Hex())
+}
+
+// Raised when setApprovalForAll is called with an invalid (zero) operator.
+type ERC1155InvalidOperatorError struct {
+	Operator common.Address
+}
+
+func (e *ERC1155InvalidOperatorError) Error() string {
+	return fmt.Sprintf("ERC1155InvalidOperator: %s cannot be approved as an operator", e.Operator.Hex())
+}
+
+// Raised by batch operations when the ids and values arrays have different lengths.
+type ERC1155InvalidArrayLengthError struct {
+	IDsLength    *big.Int
+	ValuesLength *big.Int
+}
+
+func (e *ERC1155InvalidArrayLengthError) Error() string {
+	return fmt.Sprintf("ERC1155InvalidArrayLength: %s pool IDs were passed with %s amounts -- the arrays must have the same length", e.IDsLength.String(), e.ValuesLength.String())
+}
+
+// Represents a revert with a reason string (Error(string)) or a panic (Panic(uint256)), or an error defined
+// in the ABI which this file does not have a dedicated type for.
+type RevertError struct {
+	Reason string
+	Data   []byte
+}
+
+func (e *RevertError) Error() string {
+	return fmt.Sprintf("execution reverted: %s", e.Reason)
+}
+
+// DecodeRevert decodes revert data returned by the JackpotJunction contract into a typed error. If the data
+// does not match any error in the JackpotJunction ABI, it returns a *RevertError.
+func DecodeRevert(data []byte) error {
+	if len(data) < 4 {
+		return &RevertError{Reason: "no reason given", Data: data}
+	}
+
+	reason, unpackErr := abi.UnpackRevert(data)
+	if unpackErr == nil {
+		return &RevertError{Reason: reason, Data: data}
+	}
+
+	contractABI, abiErr := JackpotJunctionMetaData.GetAbi()
+	if abiErr != nil {
+		return &RevertError{Reason: hexutil.Encode(data), Data: data}
+	}
+
+	for _, abiError := range contractABI.Errors {
+		if !bytes.Equal(abiError.ID[:4], data[:4]) {
+			continue
+		}
+
+		values, valuesErr := abiError.Inputs.Unpack(data[4:])
+		if valuesErr != nil {
+			return &RevertError{Reason: hexutil.Encode(data), Data: data}
+		}
+
+		switch abiError.Name {
+		case "DeadlineExceeded":
+			return &DeadlineExceededError{}
+		case "RollInProgress":
+			return &RollInProgressError{}
+		case "WaitForTick":
+			return &WaitForTickError{}
+		case "InsufficientValue":
+			return &InsufficientValueError{}
+		case "InvalidItem":
+			return &InvalidItemError{PoolID: values[0].(*big.Int)}
+		case "InsufficientItems":
+			return &InsufficientItemsError{PoolID: values[0].(*big.Int)}
+		case "ReentrancyGuardReentrantCall":
+			return &ReentrantCallError{}
+		case "ERC1155InsufficientBalance":
+			return &ERC1155InsufficientBalanceError{
+				Sender:  values[0].(common.Address),
+				Balance: values[1].(*big.Int),
+				Needed:  values[2].(*big.Int),
+				TokenID: values[3].(*big.Int),
+			}
+		case "ERC1155InvalidSender":
+			return &ERC1155InvalidSenderError{Sender: values[0].(common.Address)}
+		case "ERC1155InvalidReceiver":
+			return &ERC1155InvalidReceiverError{Receiver: values[0].(common.Address)}
+		case "ERC1155MissingApprovalForAll":
+			return &ERC1155MissingApprovalForAllError{Operator: values[0].(common.Address), Owner: values[1].(common.Address)}
+		case "ERC1155InvalidApprover":
+			return &ERC1155InvalidApproverError{Approver: values[0].(common.Address)}
+		case "ERC1155InvalidOperator":
+			return &ERC1155InvalidOperatorError{Operator: values[0].(common.Address)}
+		case "ERC1155InvalidArrayLength":
+			return &ERC1155InvalidArrayLengthError{IDsLength: values[0].(*big.Int), ValuesLength: values[1].(*big.Int)}
+		}
+
+		formattedValues := make([]string, len(values))
+		for i, value := range values {
+			formattedValues[i] = fmt.Sprintf("%v", value)
+		}
+		return &RevertError{Reason: fmt.Sprintf("%s(%s)", abiError.Name, strings.Join(formattedValues, ", ")), Data: data}
+	}
+
+	return &RevertError{Reason: hexutil.Encode(data), Data: data}
+}
+
+// DecodeError inspects an error returned by the bindings (for example, from a view call or from gas
+// estimation for a transaction). If the error carries revert data, it returns the decoded contract error.
+// Otherwise, it returns the original error.
+func DecodeError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return err
+	}
+
+	var data []byte
+	switch errorData := dataErr.ErrorData().(type) {
+	case string:
+		decoded, decodeErr := hexutil.Decode(errorData)
+		if decodeErr != nil {
+			return err
+		}
+		data = decoded
+	case []byte:
+		data = errorData
+	default:
+		return err
+	}
+
+	return DecodeRevert(data)
+}
@@ -0,0 +1,220 @@
+package JackpotJunction
+
+// This file is not generated. It estimates transaction fees from the recent history of the chain (using
+// eth_feeHistory) instead of relying on the defaults in go-ethereum's bind package, which overpay on chains
+// with low and stable fees.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Defaults for FeeOptions.
+const DefaultFeeHistoryBlocks uint64 = 20
+const DefaultTipPercentile float64 = 50
+const DefaultBaseFeeMultiplier uint64 = 2
+
+var ErrInvalidPercentile error = errors.New("tip percentile must be between 0 and 100")
+
+// MaxSpendExceededError is returned when a transaction cannot be priced within the maximum spend: even a
+// fee cap equal to the current base fee would allow the transaction to cost more.
+type MaxSpendExceededError struct {
+	MaxSpend *big.Int
+	MinCost  *big.Int
+}
+
+func (e MaxSpendExceededError) Error() string {
+	return fmt.Sprintf("transaction may cost up to %s wei at the current base fee, which exceeds the maximum spend of %s wei", e.MinCost.String(), e.MaxSpend.String())
+}
+
+// FeeBackend is the subset of the Ethereum JSON-RPC API that EstimateFees uses. It is satisfied by
+// *ethclient.Client.
+type FeeBackend interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+}
+
+// FeeOptions configure fee estimation. Zero values are replaced by the defaults.
+type FeeOptions struct {
+	// Number of recent blocks whose priority fees are considered.
+	HistoryBlocks uint64
+	// The priority fee is the median, over the recent blocks, of this percentile of the priority fees paid
+	// in each block.
+	TipPercentile float64
+	// The fee cap is the priority fee plus this multiple of the base fee of the next block, so that the
+	// transaction stays includable while the base fee rises.
+	BaseFeeMultiplier uint64
+}
+
+// FeeEstimate holds the fees for a transaction. On chains without EIP-1559, Legacy is true and only
+// GasPrice is set.
+type FeeEstimate struct {
+	Legacy               bool     `json:"legacy"`
+	BaseFee              *big.Int `json:"base_fee,omitempty"`
+	MaxPriorityFeePerGas *big.Int `json:"max_priority_fee_per_gas,omitempty"`
+	MaxFeePerGas         *big.Int `json:"max_fee_per_gas,omitempty"`
+	GasPrice             *big.Int `json:"gas_price,omitempty"`
+}
+
+// EstimateFees estimates the fees for a transaction which should be included in the next few blocks. It
+// falls back to the legacy gas price if the chain does not support EIP-1559 or eth_feeHistory.
+func EstimateFees(ctx context.Context, backend FeeBackend, options FeeOptions) (FeeEstimate, error) {
+	if options.HistoryBlocks == 0 {
+		options.HistoryBlocks = DefaultFeeHistoryBlocks
+	}
+	if options.TipPercentile == 0 {
+		options.TipPercentile = DefaultTipPercentile
+	}
+	if options.BaseFeeMultiplier == 0 {
+		options.BaseFeeMultiplier = DefaultBaseFeeMultiplier
+	}
+	if options.TipPercentile < 0 || options.TipPercentile > 100 {
+		return FeeEstimate{}, ErrInvalidPercentile
+	}
+
+	header, headerErr := backend.HeaderByNumber(ctx, nil)
+	if headerErr != nil {
+		return FeeEstimate{}, headerErr
+	}
+	if header.BaseFee == nil {
+		return estimateLegacyFees(ctx, backend)
+	}
+
+	history, historyErr := backend.FeeHistory(ctx, options.HistoryBlocks, header.Number, []float64{options.TipPercentile})
+	if historyErr != nil || len(history.BaseFee) == 0 {
+		return estimateLegacyFees(ctx, backend)
+	}
+
+	// Blocks without transactions report zero rewards, which say nothing about the fees needed for inclusion.
+	tips := []*big.Int{}
+	for _, rewards := range history.Reward {
+		if len(rewards) > 0 && rewards[0] != nil && rewards[0].Sign() > 0 {
+			tips = append(tips, rewards[0])
+		}
+	}
+	tip := new(big.Int)
+	if len(tips) > 0 {
+		sort.Slice(tips, func(i, j int) bool { return tips[i].Cmp(tips[j]) < 0 })
+		tip.Set(tips[len(tips)/2])
+	}
+
+	// The last base fee in the history is the base fee of the next block.
+	baseFee := history.BaseFee[len(history.BaseFee)-1]
+	maxFee := new(big.Int).Mul(baseFee, new(big.Int).SetUint64(options.BaseFeeMultiplier))
+	maxFee.Add(maxFee, tip)
+
+	return FeeEstimate{BaseFee: baseFee, MaxPriorityFeePerGas: tip, MaxFeePerGas: maxFee}, nil
+}
+
+func estimateLegacyFees(ctx context.Context, backend FeeBackend) (FeeEstimate, error) {
+	gasPrice, gasPriceErr := backend.SuggestGasPrice(ctx)
+	if gasPriceErr != nil {
+		return FeeEstimate{}, gasPriceErr
+	}
+	return FeeEstimate{Legacy: true, GasPrice: gasPrice}, nil
+}
+
+// FeeCap returns the maximum price per gas that a transaction with these fees pays.
+func (estimate FeeEstimate) FeeCap() *big.Int {
+	if estimate.Legacy {
+		return estimate.GasPrice
+	}
+	return estimate.MaxFeePerGas
+}
+
+// MaxCost returns the maximum amount (in wei) that a transaction with these fees, the given gas limit and
+// the given value can cost.
+func (estimate FeeEstimate) MaxCost(gasLimit uint64, value *big.Int) *big.Int {
+	cost := new(big.Int).Mul(estimate.FeeCap(), new(big.Int).SetUint64(gasLimit))
+	if value != nil {
+		cost.Add(cost, value)
+	}
+	return cost
+}
+
+// Cap lowers the fees so that a transaction with the given gas limit and value costs at most maxSpend
+// (including the value). The priority fee is lowered to whatever the capped fee leaves above the base fee.
+// It returns a MaxSpendExceededError if the transaction cannot be priced at or above the base fee (or, for
+// legacy transactions, at the estimated gas price) within maxSpend.
+func (estimate FeeEstimate) Cap(gasLimit uint64, value, maxSpend *big.Int) (FeeEstimate, error) {
+	if maxSpend == nil || gasLimit == 0 || estimate.MaxCost(gasLimit, value).Cmp(maxSpend) <= 0 {
+		return estimate, nil
+	}
+
+	available := new(big.Int).Set(maxSpend)
+	if value != nil {
+		available.Sub(available, value)
+	}
+	feeCap := new(big.Int)
+	if available.Sign() > 0 {
+		feeCap.Div(available, new(big.Int).SetUint64(gasLimit))
+	}
+
+	floor := estimate.BaseFee
+	if estimate.Legacy {
+		floor = estimate.GasPrice
+	}
+	if feeCap.Cmp(floor) < 0 {
+		minCost := new(big.Int).Mul(floor, new(big.Int).SetUint64(gasLimit))
+		if value != nil {
+			minCost.Add(minCost, value)
+		}
+		return estimate, MaxSpendExceededError{MaxSpend: maxSpend, MinCost: minCost}
+	}
+
+	capped := estimate
+	if estimate.Legacy {
+		capped.GasPrice = feeCap
+		return capped, nil
+	}
+	capped.MaxFeePerGas = feeCap
+	headroom := new(big.Int).Sub(feeCap, estimate.BaseFee)
+	if capped.MaxPriorityFeePerGas.Cmp(headroom) > 0 {
+		capped.MaxPriorityFeePerGas = headroom
+	}
+	return capped, nil
+}
+
+// Apply sets the fees on transaction options.
+func (estimate FeeEstimate) Apply(opts *bind.TransactOpts) {
+	if estimate.Legacy {
+		opts.GasPrice = estimate.GasPrice
+		return
+	}
+	opts.GasFeeCap = estimate.MaxFeePerGas
+	opts.GasTipCap = estimate.MaxPriorityFeePerGas
+}
+
+// String describes the fees in gwei.
+func (estimate FeeEstimate) String() string {
+	if estimate.Legacy {
+		return fmt.Sprintf("gas price %s gwei (legacy)", formatGwei(estimate.GasPrice))
+	}
+	return fmt.Sprintf("base fee %s gwei, max priority fee %s gwei, max fee %s gwei", formatGwei(estimate.BaseFee), formatGwei(estimate.MaxPriorityFeePerGas), formatGwei(estimate.MaxFeePerGas))
+}
+
+func formatGwei(wei *big.Int) string {
+	return formatUnits(wei, 9)
+}
+
+// FormatEther formats an amount of wei as a decimal amount of the native token (e.g. 0.12).
+func FormatEther(wei *big.Int) string {
+	return formatUnits(wei, 18)
+}
+
+// Formats an amount of wei in units of 10^decimals wei, without trailing zeros.
+func formatUnits(wei *big.Int, decimals int) string {
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	formatted := new(big.Rat).SetFrac(wei, unit).FloatString(decimals)
+	formatted = strings.TrimRight(formatted, "0")
+	return strings.TrimSuffix(formatted, ".")
+}
@@ -0,0 +1,120 @@
+package JackpotJunction
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Serves a fixed fee history. A nil baseFee makes it behave like a chain without EIP-1559.
+type feeBackend struct {
+	baseFee  *big.Int
+	history  *ethereum.FeeHistory
+	gasPrice *big.Int
+}
+
+func (b feeBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: big.NewInt(100), BaseFee: b.baseFee}, nil
+}
+
+func (b feeBackend) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	if b.history == nil {
+		return nil, errors.New("method not found")
+	}
+	return b.history, nil
+}
+
+func (b feeBackend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return b.gasPrice, nil
+}
+
+func TestEstimateFees(t *testing.T) {
+	backend := feeBackend{
+		baseFee: big.NewInt(90),
+		history: &ethereum.FeeHistory{
+			// Empty blocks report zero rewards and should not drag the priority fee down.
+			Reward:  [][]*big.Int{{big.NewInt(0)}, {big.NewInt(3)}, {big.NewInt(0)}, {big.NewInt(1)}, {big.NewInt(7)}},
+			BaseFee: []*big.Int{big.NewInt(80), big.NewInt(90), big.NewInt(85), big.NewInt(90), big.NewInt(95), big.NewInt(100)},
+		},
+		gasPrice: big.NewInt(1000),
+	}
+
+	fees, feesErr := EstimateFees(context.Background(), backend, FeeOptions{})
+	if feesErr != nil {
+		t.Fatalf("unexpected error: %s", feesErr.Error())
+	}
+	if fees.Legacy {
+		t.Fatalf("expected EIP-1559 fees")
+	}
+	if fees.BaseFee.Cmp(big.NewInt(100)) != 0 {
+		t.Errorf("expected base fee 100, got %s", fees.BaseFee.String())
+	}
+	if fees.MaxPriorityFeePerGas.Cmp(big.NewInt(3)) != 0 {
+		t.Errorf("expected priority fee 3, got %s", fees.MaxPriorityFeePerGas.String())
+	}
+	if fees.MaxFeePerGas.Cmp(big.NewInt(203)) != 0 {
+		t.Errorf("expected max fee 203, got %s", fees.MaxFeePerGas.String())
+	}
+
+	_, percentileErr := EstimateFees(context.Background(), backend, FeeOptions{TipPercentile: 101})
+	if !errors.Is(percentileErr, ErrInvalidPercentile) {
+		t.Errorf("expected ErrInvalidPercentile, got %v", percentileErr)
+	}
+
+	backend.history = nil
+	fallback, fallbackErr := EstimateFees(context.Background(), backend, FeeOptions{})
+	if fallbackErr != nil {
+		t.Fatalf("unexpected error: %s", fallbackErr.Error())
+	}
+	if !fallback.Legacy || fallback.GasPrice.Cmp(big.NewInt(1000)) != 0 {
+		t.Errorf("expected a legacy gas price of 1000 when eth_feeHistory is not available, got %+v", fallback)
+	}
+}
+
+func TestFeeEstimateCap(t *testing.T) {
+	fees := FeeEstimate{BaseFee: big.NewInt(100), MaxPriorityFeePerGas: big.NewInt(10), MaxFeePerGas: big.NewInt(210)}
+	value := big.NewInt(5000)
+
+	uncapped, uncappedErr := fees.Cap(100, value, big.NewInt(30000))
+	if uncappedErr != nil {
+		t.Fatalf("unexpected error: %s", uncappedErr.Error())
+	}
+	if uncapped.MaxFeePerGas.Cmp(big.NewInt(210)) != 0 {
+		t.Errorf("fees should not change within the maximum spend, got max fee %s", uncapped.MaxFeePerGas.String())
+	}
+
+	capped, cappedErr := fees.Cap(100, value, big.NewInt(15500))
+	if cappedErr != nil {
+		t.Fatalf("unexpected error: %s", cappedErr.Error())
+	}
+	if capped.MaxFeePerGas.Cmp(big.NewInt(105)) != 0 || capped.MaxPriorityFeePerGas.Cmp(big.NewInt(5)) != 0 {
+		t.Errorf("expected max fee 105 and priority fee 5, got %s and %s", capped.MaxFeePerGas.String(), capped.MaxPriorityFeePerGas.String())
+	}
+	if capped.MaxCost(100, value).Cmp(big.NewInt(15500)) > 0 {
+		t.Errorf("capped fees exceed the maximum spend")
+	}
+	if fees.MaxFeePerGas.Cmp(big.NewInt(210)) != 0 {
+		t.Errorf("Cap modified the original estimate")
+	}
+
+	atBaseFee, atBaseFeeErr := fees.Cap(100, value, big.NewInt(15000))
+	if atBaseFeeErr != nil {
+		t.Fatalf("unexpected error: %s", atBaseFeeErr.Error())
+	}
+	if atBaseFee.MaxFeePerGas.Cmp(big.NewInt(100)) != 0 || atBaseFee.MaxPriorityFeePerGas.Sign() != 0 {
+		t.Errorf("expected max fee 100 and no priority fee, got %s and %s", atBaseFee.MaxFeePerGas.String(), atBaseFee.MaxPriorityFeePerGas.String())
+	}
+
+	var exceeded MaxSpendExceededError
+	_, exceededErr := fees.Cap(100, value, big.NewInt(14000))
+	if !errors.As(exceededErr, &exceeded) {
+		t.Fatalf("expected MaxSpendExceededError, got %v", exceededErr)
+	}
+	if exceeded.MinCost.Cmp(big.NewInt(15000)) != 0 {
+		t.Errorf("expected minimum cost 15000, got %s", exceeded.MinCost.String())
+	}
+}
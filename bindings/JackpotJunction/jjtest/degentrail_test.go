@@ -0,0 +1,213 @@
+package jjtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"math/big"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/moonstream-to/degen-trail/bindings/DegenTrail"
+	"github.com/moonstream-to/degen-trail/trailmix/game"
+)
+
+// These tests compare the results of DegenTrail view methods with the Go implementations of the same game
+// logic in trailmix/game, over many random inputs. The contract is deployed with the bytecode embedded in the
+// DegenTrail binding or, if the binding has none, with the bytecode from the forge artifact.
+
+// Path of the forge artifact for DegenTrail, relative to this package.
+var degenTrailArtifact string = filepath.Join("..", "..", "..", "out", "Game.sol", "DegenTrail.json")
+
+// Returns the creation bytecode of DegenTrail, from the binding if it embeds it and from the forge artifact
+// otherwise. The test fails if neither is available.
+func degenTrailBytecode(t *testing.T) []byte {
+	t.Helper()
+
+	if bytecode := common.FromHex(DegenTrail.DegenTrailMetaData.Bin); len(bytecode) > 0 {
+		return bytecode
+	}
+
+	contents, readErr := os.ReadFile(degenTrailArtifact)
+	if errors.Is(readErr, fs.ErrNotExist) {
+		t.Fatalf("the DegenTrail binding has no bytecode and %s does not exist -- run forge build (or make test)", degenTrailArtifact)
+	} else if readErr != nil {
+		t.Fatalf("could not read %s: %s", degenTrailArtifact, readErr.Error())
+	}
+
+	var artifact struct {
+		Bytecode struct {
+			Object string `json:"object"`
+		} `json:"bytecode"`
+	}
+	if unmarshalErr := json.Unmarshal(contents, &artifact); unmarshalErr != nil {
+		t.Fatalf("could not parse %s: %s", degenTrailArtifact, unmarshalErr.Error())
+	}
+	bytecode := common.FromHex(artifact.Bytecode.Object)
+	if len(bytecode) == 0 {
+		t.Fatalf("%s has no bytecode", degenTrailArtifact)
+	}
+	return bytecode
+}
+
+// deployDegenTrail deploys a DegenTrail contract on the simulated chain of the harness. It returns the
+// contract along with the hash of the block before the one in which it was deployed, which seeds the hexes
+// that the constructor explores.
+func deployDegenTrail(t *testing.T, h *Harness) (*DegenTrail.DegenTrail, common.Hash) {
+	t.Helper()
+
+	bytecode := degenTrailBytecode(t)
+
+	contractABI, abiErr := abi.JSON(strings.NewReader(DegenTrail.DegenTrailMetaData.ABI))
+	if abiErr != nil {
+		t.Fatalf("could not parse DegenTrail ABI: %s", abiErr.Error())
+	}
+
+	var address common.Address
+	receipt, deployErr := h.Send(h.Deployer, nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		var transaction *types.Transaction
+		var err error
+		address, transaction, _, err = bind.DeployContract(opts, contractABI, bytecode, paddedGasClient{h.Client})
+		return transaction, err
+	})
+	if deployErr != nil {
+		t.Fatalf("could not deploy DegenTrail: %s", deployErr.Error())
+	}
+
+	parent, parentErr := h.Client.HeaderByNumber(context.Background(), new(big.Int).Sub(receipt.BlockNumber, big.NewInt(1)))
+	if parentErr != nil {
+		t.Fatalf("could not get the block before the deployment: %s", parentErr.Error())
+	}
+
+	contract, contractErr := DegenTrail.NewDegenTrail(address, h.Client)
+	if contractErr != nil {
+		t.Fatalf("could not bind DegenTrail: %s", contractErr.Error())
+	}
+	return contract, parent.Hash()
+}
+
+// Returns random hexes near the board (including some beyond its last column), each followed by one of its
+// neighbors, so that both outcomes of the predicates are exercised. Coordinates are never negative, as they
+// are unsigned on the contract.
+func randomHexes(rng *rand.Rand) []game.Hex {
+	hexes := []game.Hex{{I: 0, J: 0}, {I: 0, J: 198}, {I: 1, J: 199}, {I: 0, J: 200}, {I: 1, J: 201}, {I: 1 << 40, J: 0}}
+	for len(hexes) < 2*differentialSamples {
+		h := game.Hex{I: rng.Int63n(1 << 10), J: rng.Int63n(int64(game.BoardColumns) + 8)}
+		neighbor := h.Add(game.HexDirections[rng.Intn(len(game.HexDirections))])
+		if neighbor.I >= 0 && neighbor.J >= 0 {
+			hexes = append(hexes, h, neighbor)
+		}
+	}
+	return hexes
+}
+
+func TestDifferentialEnvironment(t *testing.T) {
+	h := New(t, Options{NumPlayers: 1})
+	contract, _ := deployDegenTrail(t, h)
+	rng := rand.New(rand.NewSource(differentialSeed))
+
+	rows := []uint64{0, 31, 32, 223, 224, 1<<64 - 1}
+	for k := 0; k < differentialSamples; k++ {
+		rows = append(rows, rng.Uint64())
+	}
+
+	for _, i := range rows {
+		environment, environmentErr := contract.Environment(nil, new(big.Int).SetUint64(i))
+		if environmentErr != nil {
+			t.Fatalf("environment(%d) failed: %s", i, environmentErr.Error())
+		}
+		if expected := game.Environment(i); environment.Uint64() != uint64(expected) {
+			t.Errorf("environment(%d): contract returned %s, Go returned %d", i, environment.String(), expected)
+		}
+	}
+
+	for environment := range game.EnvironmentDistributions {
+		for terrain, expected := range game.EnvironmentDistributions[environment] {
+			mass, massErr := contract.EnvironmentDistributions(nil, big.NewInt(int64(environment)), big.NewInt(int64(terrain)))
+			if massErr != nil {
+				t.Fatalf("EnvironmentDistributions(%d, %d) failed: %s", environment, terrain, massErr.Error())
+			}
+			if uint(mass) != expected {
+				t.Errorf("EnvironmentDistributions(%d, %d): contract returned %d, Go has %d", environment, terrain, mass, expected)
+			}
+		}
+	}
+}
+
+func TestDifferentialHexPredicates(t *testing.T) {
+	h := New(t, Options{NumPlayers: 1})
+	contract, _ := deployDegenTrail(t, h)
+	rng := rand.New(rand.NewSource(differentialSeed))
+
+	hexes := randomHexes(rng)
+	for k, hex := range hexes {
+		valid, hexpErr := contract.Hexp(nil, big.NewInt(hex.I), big.NewInt(hex.J))
+		if hexpErr != nil {
+			t.Fatalf("hexp(%d, %d) failed: %s", hex.I, hex.J, hexpErr.Error())
+		}
+		if valid != hex.Valid() {
+			t.Errorf("hexp(%d, %d): contract returned %t, Go returned %t", hex.I, hex.J, valid, hex.Valid())
+		}
+
+		// Pairs of consecutive hexes are often neighbors, by construction of randomHexes.
+		other := hexes[(k+1)%len(hexes)]
+		neighbors, neighborspErr := contract.Neighborsp(nil, big.NewInt(hex.I), big.NewInt(hex.J), big.NewInt(other.I), big.NewInt(other.J))
+		if neighborspErr != nil {
+			t.Fatalf("neighborsp(%d, %d, %d, %d) failed: %s", hex.I, hex.J, other.I, other.J, neighborspErr.Error())
+		}
+		if neighbors != hex.IsNeighbor(other) {
+			t.Errorf("neighborsp(%d, %d, %d, %d): contract returned %t, Go returned %t", hex.I, hex.J, other.I, other.J, neighbors, hex.IsNeighbor(other))
+		}
+	}
+}
+
+// The constructor explores row 0 with _explore, so the board right after deployment checks _explore against
+// ExploreTerrain (through GeneratedBoard), and board against ReadBoard. Each deployment seeds _explore with
+// the hash of a different block.
+func TestDifferentialBoard(t *testing.T) {
+	const deployments int = 4
+
+	h := New(t, Options{NumPlayers: 1})
+	rng := rand.New(rand.NewSource(differentialSeed))
+
+	coordinates := game.BoardWindow(0, 2, 0, game.BoardColumns/2)
+	for k := 0; k < differentialSamples; k++ {
+		coordinates = append(coordinates, [2]uint64{uint64(rng.Int63n(1 << 10)), uint64(rng.Int63n(int64(game.BoardColumns)))})
+	}
+
+	for d := 0; d < deployments; d++ {
+		h.Mine(1 + rng.Intn(5))
+		contract, parentHash := deployDegenTrail(t, h)
+
+		tiles, boardErr := game.ReadBoard(&contract.DegenTrailCaller, nil, coordinates)
+		if boardErr != nil {
+			t.Fatalf("could not read board: %s", boardErr.Error())
+		}
+		expected := game.GeneratedBoard(parentHash, coordinates)
+		if len(tiles) != len(expected) {
+			t.Fatalf("board returned %d hexes, expected %d", len(tiles), len(expected))
+		}
+
+		explored := 0
+		for k, tile := range tiles {
+			if tile != expected[k] {
+				t.Errorf("deployment seeded by %s, hex (%d, %d): contract has %+v, Go generated %+v", parentHash.Hex(), coordinates[k][0], coordinates[k][1], tile, expected[k])
+			}
+			if tile.Explored {
+				explored++
+			}
+		}
+		if explored < int(game.InitialExploredHexes) {
+			t.Errorf("deployment seeded by %s: expected all %d hexes in row 0 to be explored, got %d", parentHash.Hex(), game.InitialExploredHexes, explored)
+		}
+	}
+}
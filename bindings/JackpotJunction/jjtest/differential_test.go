@@ -0,0 +1,170 @@
+package jjtest
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/moonstream-to/degen-trail/jj/economy"
+	"github.com/moonstream-to/degen-trail/jj/entropy"
+	"github.com/moonstream-to/degen-trail/jj/items"
+)
+
+// These tests compare the results of JackpotJunction view methods with the Go implementations of the same
+// game logic, over many random inputs.
+
+// Seed for the random inputs, so that failures can be reproduced.
+const differentialSeed int64 = 1729
+
+const differentialSamples int = 256
+
+func randomUint256(rng *rand.Rand) *big.Int {
+	bytes := make([]byte, 32)
+	rng.Read(bytes)
+	return new(big.Int).SetBytes(bytes)
+}
+
+func TestDifferentialParameters(t *testing.T) {
+	h := New(t, Options{})
+
+	parameters, parametersErr := economy.ReadParameters(&h.Contract.JackpotJunctionCaller, nil)
+	if parametersErr != nil {
+		t.Fatalf("could not read parameters: %s", parametersErr.Error())
+	}
+	if parameters.UnmodifiedOutcomesCumulativeMass != economy.UnmodifiedOutcomesCumulativeMass {
+		t.Errorf("unmodified cumulative mass on the contract is %v, Go has %v", parameters.UnmodifiedOutcomesCumulativeMass, economy.UnmodifiedOutcomesCumulativeMass)
+	}
+	if parameters.ImprovedOutcomesCumulativeMass != economy.ImprovedOutcomesCumulativeMass {
+		t.Errorf("improved cumulative mass on the contract is %v, Go has %v", parameters.ImprovedOutcomesCumulativeMass, economy.ImprovedOutcomesCumulativeMass)
+	}
+}
+
+func TestDifferentialSampleOutcome(t *testing.T) {
+	h := New(t, Options{})
+	rng := rand.New(rand.NewSource(differentialSeed))
+
+	entropies := make([]*big.Int, 0, differentialSamples+4*economy.NumOutcomes)
+	for i := 0; i < differentialSamples; i++ {
+		entropies = append(entropies, randomUint256(rng))
+	}
+	// Samples on either side of each boundary between outcomes, under random high bits.
+	for _, cumulativeMass := range [][economy.NumOutcomes]uint64{economy.UnmodifiedOutcomesCumulativeMass, economy.ImprovedOutcomesCumulativeMass} {
+		for _, mass := range cumulativeMass[:economy.NumOutcomes-1] {
+			for _, sample := range []uint64{mass - 1, mass} {
+				high := new(big.Int).Rsh(randomUint256(rng), 20)
+				high.Lsh(high, 20)
+				entropies = append(entropies, high.Or(high, new(big.Int).SetUint64(sample)))
+			}
+		}
+	}
+
+	for _, value := range entropies {
+		sample := uint64(entropy.Reduce(value).OutcomeSample)
+
+		unmodified, unmodifiedErr := h.Contract.SampleUnmodifiedOutcomeCumulativeMass(nil, value)
+		if unmodifiedErr != nil {
+			t.Fatalf("sampleUnmodifiedOutcomeCumulativeMass(%s) failed: %s", value.String(), unmodifiedErr.Error())
+		}
+		if expected := economy.SampleOutcome(economy.UnmodifiedOutcomesCumulativeMass, sample); unmodified.Uint64() != expected {
+			t.Errorf("sampleUnmodifiedOutcomeCumulativeMass(%s): contract returned %s, Go returned %d", value.String(), unmodified.String(), expected)
+		}
+
+		improved, improvedErr := h.Contract.SampleImprovedOutcomesCumulativeMass(nil, value)
+		if improvedErr != nil {
+			t.Fatalf("sampleImprovedOutcomesCumulativeMass(%s) failed: %s", value.String(), improvedErr.Error())
+		}
+		if expected := economy.SampleOutcome(economy.ImprovedOutcomesCumulativeMass, sample); improved.Uint64() != expected {
+			t.Errorf("sampleImprovedOutcomesCumulativeMass(%s): contract returned %s, Go returned %d", value.String(), improved.String(), expected)
+		}
+	}
+}
+
+func TestDifferentialGenera(t *testing.T) {
+	h := New(t, Options{})
+	rng := rand.New(rand.NewSource(differentialSeed))
+
+	poolIDs := make([]uint64, 0, differentialSamples+int(items.PoolsPerTier))
+	for i := uint64(0); i < items.PoolsPerTier; i++ {
+		poolIDs = append(poolIDs, i)
+	}
+	for i := 0; i < differentialSamples; i++ {
+		poolIDs = append(poolIDs, rng.Uint64())
+	}
+
+	for _, poolID := range poolIDs {
+		genera, generaErr := h.Contract.Genera(nil, new(big.Int).SetUint64(poolID))
+		if generaErr != nil {
+			t.Fatalf("genera(%d) failed: %s", poolID, generaErr.Error())
+		}
+
+		item := items.FromPoolID(poolID)
+		if genera.ItemType.Uint64() != uint64(item.Kind) || genera.TerrainType.Uint64() != uint64(item.Terrain) || genera.Tier.Uint64() != item.Tier {
+			t.Errorf("genera(%d): contract returned (%s, %s, %s), Go returned (%d, %d, %d)", poolID, genera.ItemType.String(), genera.TerrainType.String(), genera.Tier.String(), item.Kind, item.Terrain, item.Tier)
+		}
+		if item.PoolID() != poolID {
+			t.Errorf("item %s encodes to pool ID %d, expected %d", item.String(), item.PoolID(), poolID)
+		}
+	}
+}
+
+// Rolls for several players and compares the outcomes that the contract previews with the outcomes computed
+// in Go from the hash of the block of each roll.
+func TestDifferentialOutcome(t *testing.T) {
+	const rollsPerPlayer int = 20
+
+	h := New(t, Options{})
+
+	for _, player := range h.Players {
+		for i := 0; i < rollsPerPlayer; i++ {
+			if _, rollErr := h.Roll(player); rollErr != nil {
+				t.Fatalf("could not roll: %s", rollErr.Error())
+			}
+			h.Mine(1)
+
+			lastRollBlock, lastRollBlockErr := h.Contract.LastRollBlock(nil, player.Address)
+			if lastRollBlockErr != nil {
+				t.Fatalf("could not get LastRollBlock: %s", lastRollBlockErr.Error())
+			}
+			header, headerErr := h.Client.HeaderByNumber(context.Background(), lastRollBlock)
+			if headerErr != nil {
+				t.Fatalf("could not get block %s: %s", lastRollBlock.String(), headerErr.Error())
+			}
+			balance := h.BalanceAt(h.Address)
+
+			expectedEntropy := entropy.Entropy(header.Hash(), player.Address)
+			reductions := entropy.Reduce(expectedEntropy)
+
+			for _, bonus := range []bool{false, true} {
+				contractEntropy, contractOutcome, contractReward, outcomeErr := h.Contract.Outcome(nil, player.Address, bonus)
+				if outcomeErr != nil {
+					t.Fatalf("outcome failed: %s", outcomeErr.Error())
+				}
+
+				if contractEntropy.Cmp(expectedEntropy) != 0 {
+					t.Fatalf("entropy for roll in block %s: contract returned %s, Go returned %s", lastRollBlock.String(), contractEntropy.String(), expectedEntropy.String())
+				}
+
+				cumulativeMass := economy.UnmodifiedOutcomesCumulativeMass
+				if bonus {
+					cumulativeMass = economy.ImprovedOutcomesCumulativeMass
+				}
+				expectedOutcome := economy.SampleOutcome(cumulativeMass, uint64(reductions.OutcomeSample))
+				if contractOutcome.Uint64() != expectedOutcome {
+					t.Errorf("outcome (bonus: %t) for roll in block %s: contract returned %s, Go returned %d", bonus, lastRollBlock.String(), contractOutcome.String(), expectedOutcome)
+					continue
+				}
+
+				var expectedReward *big.Int
+				if expectedOutcome == 1 {
+					expectedReward = items.Item{Terrain: items.Terrain(reductions.TerrainType), Kind: items.Kind(reductions.ItemType)}.Big()
+				} else if expectedOutcome > 1 {
+					expectedReward = economy.Reward(expectedOutcome, h.CostToRoll, balance)
+				}
+				if expectedReward != nil && contractReward.Cmp(expectedReward) != 0 {
+					t.Errorf("reward for outcome %d (bonus: %t) for roll in block %s: contract returned %s, Go returned %s", expectedOutcome, bonus, lastRollBlock.String(), contractReward.String(), expectedReward.String())
+				}
+			}
+		}
+	}
+}
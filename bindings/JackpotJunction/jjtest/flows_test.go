@@ -0,0 +1,254 @@
+package jjtest
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction"
+)
+
+// These tests cover the player flows described in design/jj-flows.md.
+
+func TestDeployment(t *testing.T) {
+	h := New(t, Options{})
+
+	blocksToAct, blocksToActErr := h.Contract.BlocksToAct(nil)
+	if blocksToActErr != nil || blocksToAct.Cmp(h.BlocksToAct) != 0 {
+		t.Errorf("expected BlocksToAct %s, got %v (error: %v)", h.BlocksToAct.String(), blocksToAct, blocksToActErr)
+	}
+	costToRoll, costToRollErr := h.Contract.CostToRoll(nil)
+	if costToRollErr != nil || costToRoll.Cmp(h.CostToRoll) != 0 {
+		t.Errorf("expected CostToRoll %s, got %v (error: %v)", h.CostToRoll.String(), costToRoll, costToRollErr)
+	}
+	costToReroll, costToRerollErr := h.Contract.CostToReroll(nil)
+	if costToRerollErr != nil || costToReroll.Cmp(h.CostToReroll) != 0 {
+		t.Errorf("expected CostToReroll %s, got %v (error: %v)", h.CostToReroll.String(), costToReroll, costToRerollErr)
+	}
+}
+
+func TestRollAndReroll(t *testing.T) {
+	h := New(t, Options{})
+	player := h.Players[0]
+
+	_, insufficientErr := h.Send(player, new(big.Int).Sub(h.CostToRoll, big.NewInt(1)), func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.Contract.Roll(opts)
+	})
+	var insufficientValue *JackpotJunction.InsufficientValueError
+	if !errors.As(insufficientErr, &insufficientValue) {
+		t.Fatalf("expected InsufficientValue when rolling for less than CostToRoll, got %v", insufficientErr)
+	}
+
+	if h.RollCost(player).Cmp(h.CostToRoll) != 0 {
+		t.Fatalf("expected the first roll to cost CostToRoll")
+	}
+	receipt, rollErr := h.Roll(player)
+	if rollErr != nil {
+		t.Fatalf("could not roll: %s", rollErr.Error())
+	}
+	lastRollBlock, _ := h.Contract.LastRollBlock(nil, player.Address)
+	if lastRollBlock.Cmp(receipt.BlockNumber) != 0 {
+		t.Errorf("expected LastRollBlock %s, got %s", receipt.BlockNumber.String(), lastRollBlock.String())
+	}
+
+	if h.RollCost(player).Cmp(h.CostToReroll) != 0 {
+		t.Fatalf("expected a reroll within BlocksToAct to cost CostToReroll")
+	}
+	rerollReceipt, rerollErr := h.Roll(player)
+	if rerollErr != nil {
+		t.Fatalf("could not reroll: %s", rerollErr.Error())
+	}
+	lastRollBlock, _ = h.Contract.LastRollBlock(nil, player.Address)
+	if lastRollBlock.Cmp(rerollReceipt.BlockNumber) != 0 {
+		t.Errorf("expected LastRollBlock %s after reroll, got %s", rerollReceipt.BlockNumber.String(), lastRollBlock.String())
+	}
+
+	expectedBalance := new(big.Int).Add(h.CostToRoll, h.CostToReroll)
+	if balance := h.BalanceAt(h.Address); balance.Cmp(expectedBalance) != 0 {
+		t.Errorf("expected contract balance %s, got %s", expectedBalance.String(), balance.String())
+	}
+}
+
+func TestBlockCountdownAndPreview(t *testing.T) {
+	h := New(t, Options{})
+	player := h.Players[0]
+
+	if _, rollErr := h.Roll(player); rollErr != nil {
+		t.Fatalf("could not roll: %s", rollErr.Error())
+	}
+
+	var waitForTick *JackpotJunction.WaitForTickError
+	if _, outcomeErr := h.Outcome(player); !errors.As(outcomeErr, &waitForTick) {
+		t.Errorf("expected WaitForTick when previewing in the block of the roll, got %v", outcomeErr)
+	}
+
+	h.Mine(1)
+	first, firstErr := h.Outcome(player)
+	if firstErr != nil {
+		t.Fatalf("could not preview outcome: %s", firstErr.Error())
+	}
+	if first.Outcome.Cmp(big.NewInt(4)) > 0 {
+		t.Errorf("outcome %s is out of range", first.Outcome.String())
+	}
+
+	// The outcome depends only on the block of the roll, so it stays the same until the deadline.
+	h.Mine(int(h.BlocksToAct.Int64()) - 1)
+	last, lastErr := h.Outcome(player)
+	if lastErr != nil {
+		t.Fatalf("could not preview outcome at the deadline: %s", lastErr.Error())
+	}
+	if last.Entropy.Cmp(first.Entropy) != 0 || last.Outcome.Cmp(first.Outcome) != 0 {
+		t.Errorf("outcome changed between previews")
+	}
+
+	h.Mine(1)
+	var deadlineExceeded *JackpotJunction.DeadlineExceededError
+	if _, outcomeErr := h.Outcome(player); !errors.As(outcomeErr, &deadlineExceeded) {
+		t.Errorf("expected DeadlineExceeded when previewing after the deadline, got %v", outcomeErr)
+	}
+}
+
+func TestAcceptMatchesPreview(t *testing.T) {
+	h := New(t, Options{})
+	player := h.Players[0]
+
+	if _, rollErr := h.Roll(player); rollErr != nil {
+		t.Fatalf("could not roll: %s", rollErr.Error())
+	}
+	h.Mine(1)
+
+	preview, previewErr := h.Outcome(player)
+	if previewErr != nil {
+		t.Fatalf("could not preview outcome: %s", previewErr.Error())
+	}
+	award, acceptErr := h.Accept(player)
+	if acceptErr != nil {
+		t.Fatalf("could not accept: %s", acceptErr.Error())
+	}
+	if award.Player != player.Address || award.Outcome.Cmp(preview.Outcome) != 0 || award.Value.Cmp(preview.Reward) != 0 {
+		t.Errorf("award (outcome %s, value %s) does not match preview (outcome %s, reward %s)", award.Outcome.String(), award.Value.String(), preview.Outcome.String(), preview.Reward.String())
+	}
+
+	lastRollBlock, _ := h.Contract.LastRollBlock(nil, player.Address)
+	if lastRollBlock.Sign() != 0 {
+		t.Errorf("expected LastRollBlock to be cleared after accept, got %s", lastRollBlock.String())
+	}
+}
+
+func TestAbandonRoll(t *testing.T) {
+	h := New(t, Options{})
+	player := h.Players[0]
+
+	if _, rollErr := h.Roll(player); rollErr != nil {
+		t.Fatalf("could not roll: %s", rollErr.Error())
+	}
+	h.Mine(int(h.BlocksToAct.Int64()) + 1)
+
+	var deadlineExceeded *JackpotJunction.DeadlineExceededError
+	if _, acceptErr := h.Accept(player); !errors.As(acceptErr, &deadlineExceeded) {
+		t.Fatalf("expected DeadlineExceeded when accepting after the deadline, got %v", acceptErr)
+	}
+
+	if h.RollCost(player).Cmp(h.CostToRoll) != 0 {
+		t.Fatalf("expected a roll after the deadline to cost CostToRoll")
+	}
+	_, rerollErr := h.Send(player, h.CostToReroll, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.Contract.Roll(opts)
+	})
+	var insufficientValue *JackpotJunction.InsufficientValueError
+	if !errors.As(rerollErr, &insufficientValue) {
+		t.Errorf("expected InsufficientValue when rerolling after the deadline, got %v", rerollErr)
+	}
+}
+
+func TestEquipAndUnequip(t *testing.T) {
+	h := New(t, Options{})
+	player := h.Players[0]
+
+	poolID, itemErr := h.RollForItem(player)
+	if itemErr != nil {
+		t.Fatalf("could not win an item: %s", itemErr.Error())
+	}
+	itemType := new(big.Int).Mod(poolID, big.NewInt(4)).Int64()
+	equipped := map[int64]func() (*big.Int, error){
+		0: func() (*big.Int, error) { return h.Contract.EquippedCover(nil, player.Address) },
+		1: func() (*big.Int, error) { return h.Contract.EquippedBody(nil, player.Address) },
+		2: func() (*big.Int, error) { return h.Contract.EquippedWheels(nil, player.Address) },
+		3: func() (*big.Int, error) { return h.Contract.EquippedBeasts(nil, player.Address) },
+	}[itemType]
+
+	if _, rollErr := h.Roll(player); rollErr != nil {
+		t.Fatalf("could not roll: %s", rollErr.Error())
+	}
+	var rollInProgress *JackpotJunction.RollInProgressError
+	if _, equipErr := h.Equip(player, poolID); !errors.As(equipErr, &rollInProgress) {
+		t.Fatalf("expected RollInProgress when equipping during a roll, got %v", equipErr)
+	}
+	h.Mine(int(h.BlocksToAct.Int64()) + 1)
+
+	if _, equipErr := h.Equip(player, poolID); equipErr != nil {
+		t.Fatalf("could not equip item %s: %s", poolID.String(), equipErr.Error())
+	}
+	slot, _ := equipped()
+	if slot.Cmp(new(big.Int).Add(poolID, big.NewInt(1))) != 0 {
+		t.Errorf("expected slot to hold pool ID %s + 1, got %s", poolID.String(), slot.String())
+	}
+	if h.ItemBalance(h.Address, poolID).Cmp(big.NewInt(1)) != 0 {
+		t.Errorf("expected the equipped item to be held by the contract")
+	}
+
+	if _, unequipErr := h.Unequip(player); unequipErr != nil {
+		t.Fatalf("could not unequip: %s", unequipErr.Error())
+	}
+	slot, _ = equipped()
+	if slot.Sign() != 0 {
+		t.Errorf("expected slot to be empty after unequip, got %s", slot.String())
+	}
+	if h.ItemBalance(player.Address, poolID).Sign() == 0 {
+		t.Errorf("expected the unequipped item to be returned to the player")
+	}
+}
+
+func TestCraft(t *testing.T) {
+	h := New(t, Options{})
+	player := h.Players[0]
+
+	var poolID *big.Int
+	won := map[string]int{}
+	for poolID == nil {
+		item, itemErr := h.RollForItem(player)
+		if itemErr != nil {
+			t.Fatalf("could not win an item: %s", itemErr.Error())
+		}
+		won[item.String()]++
+		if won[item.String()] == 2 {
+			poolID = item
+		}
+	}
+
+	var insufficientItems *JackpotJunction.InsufficientItemsError
+	if _, craftErr := h.Craft(player, poolID, 2); !errors.As(craftErr, &insufficientItems) {
+		t.Fatalf("expected InsufficientItems when crafting without enough items, got %v", craftErr)
+	}
+
+	if _, craftErr := h.Craft(player, poolID, 1); craftErr != nil {
+		t.Fatalf("could not craft: %s", craftErr.Error())
+	}
+	newPoolID := new(big.Int).Add(poolID, big.NewInt(28))
+	if h.ItemBalance(player.Address, poolID).Sign() != 0 {
+		t.Errorf("expected crafting to burn both input items")
+	}
+	if h.ItemBalance(player.Address, newPoolID).Cmp(big.NewInt(1)) != 0 {
+		t.Errorf("expected crafting to mint one item with pool ID %s", newPoolID.String())
+	}
+
+	itemType := new(big.Int).Mod(poolID, big.NewInt(4))
+	terrainType := new(big.Int).Div(new(big.Int).Mod(poolID, big.NewInt(28)), big.NewInt(4))
+	tier, tierErr := h.Contract.CurrentTier(nil, itemType, terrainType)
+	if tierErr != nil || tier.Cmp(big.NewInt(1)) != 0 {
+		t.Errorf("expected crafting to unlock tier 1, got %v (error: %v)", tier, tierErr)
+	}
+}
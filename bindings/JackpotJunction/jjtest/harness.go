@@ -0,0 +1,360 @@
+// Package jjtest runs JackpotJunction contracts on an in-process simulated chain, so that the Go bindings and
+// the code built on them can be tested without a network.
+//
+// A Harness deploys a JackpotJunction contract on a fresh simulated chain with funded player accounts, and
+// provides helpers for the player flows described in design/jj-flows.md:
+//
+//	h := jjtest.New(t, jjtest.Options{})
+//	player := h.Players[0]
+//	if _, err := h.Roll(player); err != nil {
+//		t.Fatal(err)
+//	}
+//	h.Mine(1)
+//	award, err := h.Accept(player)
+//
+// Every transaction is mined in its own block as soon as it is sent. View calls and gas estimates see the
+// latest block, so a transaction which reverts when its gas is estimated fails to send, with the revert error
+// decoded by JackpotJunction.DecodeError (tests can check for it with errors.As). Note that the transaction
+// itself is mined in the block after the latest one.
+//
+// The outcomes of rolls depend on block hashes, which are not predictable on the simulated chain. Tests
+// which need particular outcomes should roll until they get them (see RollUntil).
+package jjtest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction"
+)
+
+// Defaults for Options.
+const DefaultNumPlayers int = 3
+const DefaultBlocksToAct int64 = 10
+
+var DefaultCostToRoll *big.Int = big.NewInt(1e15)
+var DefaultCostToReroll *big.Int = big.NewInt(25e13)
+var DefaultBalance *big.Int = new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18))
+
+// Default maximum number of rolls that RollUntil makes before it gives up.
+const DefaultMaxRolls int = 1000
+
+var ErrNoAward error = errors.New("the transaction did not emit an Award event")
+
+// Options configure a Harness. Zero values are replaced by the defaults.
+type Options struct {
+	NumPlayers   int
+	BlocksToAct  *big.Int
+	CostToRoll   *big.Int
+	CostToReroll *big.Int
+	// Initial balance of the deployer and of each player, in wei.
+	Balance *big.Int
+}
+
+// Account is a funded account on the simulated chain.
+type Account struct {
+	Key     *ecdsa.PrivateKey
+	Address common.Address
+}
+
+// Harness is a JackpotJunction contract deployed on a simulated chain.
+type Harness struct {
+	t        testing.TB
+	Backend  *simulated.Backend
+	Client   simulated.Client
+	ChainID  *big.Int
+	Deployer *Account
+	Players  []*Account
+
+	Address      common.Address
+	Contract     *JackpotJunction.JackpotJunction
+	BlocksToAct  *big.Int
+	CostToRoll   *big.Int
+	CostToReroll *big.Int
+}
+
+// New starts a simulated chain, funds the deployer and player accounts, and deploys a JackpotJunction
+// contract from the deployer account. The chain is shut down when the test finishes.
+func New(t testing.TB, options Options) *Harness {
+	t.Helper()
+
+	if options.NumPlayers == 0 {
+		options.NumPlayers = DefaultNumPlayers
+	}
+	if options.BlocksToAct == nil {
+		options.BlocksToAct = big.NewInt(DefaultBlocksToAct)
+	}
+	if options.CostToRoll == nil {
+		options.CostToRoll = DefaultCostToRoll
+	}
+	if options.CostToReroll == nil {
+		options.CostToReroll = DefaultCostToReroll
+	}
+	if options.Balance == nil {
+		options.Balance = DefaultBalance
+	}
+
+	accounts := make([]*Account, options.NumPlayers+1)
+	alloc := types.GenesisAlloc{}
+	for i := range accounts {
+		key, keyErr := crypto.GenerateKey()
+		if keyErr != nil {
+			t.Fatalf("could not generate account key: %s", keyErr.Error())
+		}
+		accounts[i] = &Account{Key: key, Address: crypto.PubkeyToAddress(key.PublicKey)}
+		alloc[accounts[i].Address] = types.Account{Balance: new(big.Int).Set(options.Balance)}
+	}
+
+	backend := simulated.NewBackend(alloc)
+	t.Cleanup(func() {
+		backend.Close()
+	})
+
+	h := &Harness{
+		t:            t,
+		Backend:      backend,
+		Client:       backend.Client(),
+		Deployer:     accounts[0],
+		Players:      accounts[1:],
+		BlocksToAct:  options.BlocksToAct,
+		CostToRoll:   options.CostToRoll,
+		CostToReroll: options.CostToReroll,
+	}
+
+	chainID, chainIDErr := h.Client.ChainID(context.Background())
+	if chainIDErr != nil {
+		t.Fatalf("could not get chain ID of simulated chain: %s", chainIDErr.Error())
+	}
+	h.ChainID = chainID
+
+	var contract *JackpotJunction.JackpotJunction
+	_, deployErr := h.Send(h.Deployer, nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		var transaction *types.Transaction
+		var err error
+		h.Address, transaction, contract, err = JackpotJunction.DeployJackpotJunction(opts, paddedGasClient{h.Client}, h.BlocksToAct, h.CostToRoll, h.CostToReroll)
+		return transaction, err
+	})
+	if deployErr != nil {
+		t.Fatalf("could not deploy JackpotJunction: %s", deployErr.Error())
+	}
+	h.Contract = contract
+
+	// Until block BlocksToAct, the contract treats every roll as a reroll.
+	h.Mine(int(h.BlocksToAct.Int64()))
+
+	return h
+}
+
+// Gas is estimated at the latest block, but transactions are executed in the next block, where they may use
+// more gas. For example, a reroll in the block after a roll writes a new LastRollBlock, while the estimate
+// rewrites the same value. paddedGasClient pads gas estimates so that such transactions do not run out of gas.
+type paddedGasClient struct {
+	simulated.Client
+}
+
+func (client paddedGasClient) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	gas, gasErr := client.Client.EstimateGas(ctx, msg)
+	return gas * 3 / 2, gasErr
+}
+
+// TransactOpts returns transaction options which send transactions from the given account with the given
+// value (which may be nil).
+func (h *Harness) TransactOpts(account *Account, value *big.Int) *bind.TransactOpts {
+	opts, optsErr := bind.NewKeyedTransactorWithChainID(account.Key, h.ChainID)
+	if optsErr != nil {
+		h.t.Fatalf("could not create transaction options: %s", optsErr.Error())
+	}
+	opts.Context = context.Background()
+	opts.Value = value
+	return opts
+}
+
+// Send sends the transaction created by send from the given account, mines it, and returns its receipt.
+// It returns the decoded revert error if the transaction would revert, and an error if it was mined but
+// failed.
+func (h *Harness) Send(account *Account, value *big.Int, send func(opts *bind.TransactOpts) (*types.Transaction, error)) (*types.Receipt, error) {
+	transaction, sendErr := send(h.TransactOpts(account, value))
+	if sendErr != nil {
+		return nil, JackpotJunction.DecodeError(sendErr)
+	}
+	h.Backend.Commit()
+
+	receipt, receiptErr := h.Client.TransactionReceipt(context.Background(), transaction.Hash())
+	if receiptErr != nil {
+		return nil, receiptErr
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("transaction %s failed", transaction.Hash().Hex())
+	}
+	return receipt, nil
+}
+
+// Mine mines the given number of empty blocks.
+func (h *Harness) Mine(blocks int) {
+	for i := 0; i < blocks; i++ {
+		h.Backend.Commit()
+	}
+}
+
+// BlockNumber returns the number of the latest block.
+func (h *Harness) BlockNumber() uint64 {
+	blockNumber, blockNumberErr := h.Client.BlockNumber(context.Background())
+	if blockNumberErr != nil {
+		h.t.Fatalf("could not get block number: %s", blockNumberErr.Error())
+	}
+	return blockNumber
+}
+
+// RPC returns the JSON-RPC client of the simulated chain, for code which makes raw or batched requests (such
+// as JackpotJunction.Batch.Execute).
+func (h *Harness) RPC() *rpc.Client {
+	// simulated.Client wraps an *ethclient.Client in a struct whose embedded field hides its Client method.
+	wrapped, ok := reflect.ValueOf(h.Client).FieldByName("Client").Interface().(*ethclient.Client)
+	if !ok {
+		h.t.Fatalf("could not get the JSON-RPC client of the simulated chain")
+	}
+	return wrapped.Client()
+}
+
+// BalanceAt returns the native token balance of the given address.
+func (h *Harness) BalanceAt(address common.Address) *big.Int {
+	balance, balanceErr := h.Client.BalanceAt(context.Background(), address, nil)
+	if balanceErr != nil {
+		h.t.Fatalf("could not get balance of %s: %s", address.Hex(), balanceErr.Error())
+	}
+	return balance
+}
+
+// RollCost returns the value that the player must send to roll in the next block: CostToReroll if they
+// are within BlocksToAct blocks of their last roll, and CostToRoll otherwise.
+func (h *Harness) RollCost(player *Account) *big.Int {
+	lastRollBlock, lastRollBlockErr := h.Contract.LastRollBlock(nil, player.Address)
+	if lastRollBlockErr != nil {
+		h.t.Fatalf("could not get LastRollBlock: %s", lastRollBlockErr.Error())
+	}
+	nextBlock := new(big.Int).SetUint64(h.BlockNumber() + 1)
+	if nextBlock.Cmp(new(big.Int).Add(lastRollBlock, h.BlocksToAct)) <= 0 {
+		return h.CostToReroll
+	}
+	return h.CostToRoll
+}
+
+// Roll rolls (or rerolls) for the player, paying the cost given by RollCost.
+func (h *Harness) Roll(player *Account) (*types.Receipt, error) {
+	return h.Send(player, h.RollCost(player), func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.Contract.Roll(opts)
+	})
+}
+
+// Outcome previews the outcome that accept would award the player in the latest block.
+func (h *Harness) Outcome(player *Account) (JackpotJunction.OutcomeResult, error) {
+	bonus, bonusErr := h.Contract.HasBonus(nil, player.Address)
+	if bonusErr != nil {
+		return JackpotJunction.OutcomeResult{}, JackpotJunction.DecodeError(bonusErr)
+	}
+	entropy, outcome, reward, outcomeErr := h.Contract.Outcome(nil, player.Address, bonus)
+	if outcomeErr != nil {
+		return JackpotJunction.OutcomeResult{}, JackpotJunction.DecodeError(outcomeErr)
+	}
+	return JackpotJunction.OutcomeResult{Entropy: entropy, Outcome: outcome, Reward: reward}, nil
+}
+
+// Accept accepts the outcome of the player's last roll and returns the Award event it emitted.
+func (h *Harness) Accept(player *Account) (*JackpotJunction.JackpotJunctionAward, error) {
+	receipt, acceptErr := h.Send(player, nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.Contract.Accept(opts)
+	})
+	if acceptErr != nil {
+		return nil, acceptErr
+	}
+
+	for _, log := range receipt.Logs {
+		award, parseErr := h.Contract.ParseAward(*log)
+		if parseErr == nil {
+			return award, nil
+		}
+	}
+	return nil, ErrNoAward
+}
+
+// RollUntil rolls for the player (then rerolls) until the preview of the outcome satisfies want, and accepts
+// that outcome. It gives up after maxRolls rolls (DefaultMaxRolls if maxRolls is 0).
+func (h *Harness) RollUntil(player *Account, want func(outcome JackpotJunction.OutcomeResult) bool, maxRolls int) (*JackpotJunction.JackpotJunctionAward, error) {
+	if maxRolls == 0 {
+		maxRolls = DefaultMaxRolls
+	}
+
+	for i := 0; i < maxRolls; i++ {
+		_, rollErr := h.Roll(player)
+		if rollErr != nil {
+			return nil, rollErr
+		}
+		h.Mine(1)
+
+		outcome, outcomeErr := h.Outcome(player)
+		if outcomeErr != nil {
+			return nil, outcomeErr
+		}
+		if want(outcome) {
+			return h.Accept(player)
+		}
+	}
+
+	return nil, fmt.Errorf("did not get the desired outcome in %d rolls", maxRolls)
+}
+
+// RollForItem rolls until the player wins an item, accepts it, and returns its pool ID.
+func (h *Harness) RollForItem(player *Account) (*big.Int, error) {
+	award, awardErr := h.RollUntil(player, func(outcome JackpotJunction.OutcomeResult) bool {
+		return outcome.Outcome.Int64() == 1
+	}, 0)
+	if awardErr != nil {
+		return nil, awardErr
+	}
+	return award.Value, nil
+}
+
+// Equip equips the items with the given pool IDs on the player.
+func (h *Harness) Equip(player *Account, poolIDs ...*big.Int) (*types.Receipt, error) {
+	return h.Send(player, nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.Contract.Equip(opts, poolIDs)
+	})
+}
+
+// Unequip unequips all the items that the player has equipped.
+func (h *Harness) Unequip(player *Account) (*types.Receipt, error) {
+	return h.Send(player, nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.Contract.Unequip(opts)
+	})
+}
+
+// Craft crafts numOutputs items of the next tier from 2*numOutputs of the player's items with the given
+// pool ID.
+func (h *Harness) Craft(player *Account, poolID *big.Int, numOutputs int64) (*types.Receipt, error) {
+	return h.Send(player, nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.Contract.Craft(opts, poolID, big.NewInt(numOutputs))
+	})
+}
+
+// ItemBalance returns the number of items with the given pool ID that the account holds.
+func (h *Harness) ItemBalance(address common.Address, poolID *big.Int) *big.Int {
+	balance, balanceErr := h.Contract.BalanceOf(nil, address, poolID)
+	if balanceErr != nil {
+		h.t.Fatalf("could not get balance of pool %s: %s", poolID.String(), balanceErr.Error())
+	}
+	return balance
+}
@@ -0,0 +1,251 @@
+package jjtest
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction"
+)
+
+// Counts the JSON-RPC batches that Execute sends.
+type countingRPC struct {
+	*rpc.Client
+	batchSizes []int
+}
+
+func (client *countingRPC) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	client.batchSizes = append(client.batchSizes, len(b))
+	return client.Client.BatchCallContext(ctx, b)
+}
+
+const aggregate3ABI string = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+
+// Stands in for a Multicall3 contract at JackpotJunction.Multicall3Address, which is not deployed on the
+// simulated chain: aggregate3 calls are answered by making each of the aggregated calls on the chain.
+type multicall3StandIn struct {
+	simulated.Client
+	t          *testing.T
+	aggregates int
+}
+
+func (standIn *multicall3StandIn) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if msg.To == nil || *msg.To != JackpotJunction.Multicall3Address {
+		return standIn.Client.CallContract(ctx, msg, blockNumber)
+	}
+	standIn.aggregates++
+
+	multicallABI, _ := abi.JSON(strings.NewReader(aggregate3ABI))
+	method := multicallABI.Methods["aggregate3"]
+	in, unpackErr := method.Inputs.Unpack(msg.Data[4:])
+	if unpackErr != nil {
+		standIn.t.Fatalf("could not unpack aggregate3 input: %s", unpackErr.Error())
+	}
+	calls := *abi.ConvertType(in[0], new([]struct {
+		Target       common.Address
+		AllowFailure bool
+		CallData     []byte
+	})).(*[]struct {
+		Target       common.Address
+		AllowFailure bool
+		CallData     []byte
+	})
+
+	type result struct {
+		Success    bool
+		ReturnData []byte
+	}
+	results := make([]result, len(calls))
+	for i, call := range calls {
+		output, callErr := standIn.Client.CallContract(ctx, ethereum.CallMsg{To: &call.Target, Data: call.CallData}, blockNumber)
+		if callErr == nil {
+			results[i] = result{Success: true, ReturnData: output}
+			continue
+		}
+		var dataErr rpc.DataError
+		if !errors.As(callErr, &dataErr) {
+			return nil, callErr
+		}
+		revertData, _ := hexutil.Decode(dataErr.ErrorData().(string))
+		results[i] = result{ReturnData: revertData}
+	}
+	return method.Outputs.Pack(results)
+}
+
+// The calls that both TestBatchAtPinnedBlock and TestBatchMulticall3 make.
+type mixedBatch struct {
+	costToRoll    *JackpotJunction.Result[*big.Int]
+	lastRollBlock *JackpotJunction.Result[*big.Int]
+	outcome       *JackpotJunction.Result[JackpotJunction.OutcomeResult]
+	rewards       *JackpotJunction.Result[JackpotJunction.CurrentRewardsResult]
+	hasBonus      *JackpotJunction.Result[bool]
+}
+
+func newMixedBatch(t *testing.T, h *Harness, player *Account) (*JackpotJunction.Batch, mixedBatch) {
+	batch, batchErr := JackpotJunction.NewBatch(h.Address)
+	if batchErr != nil {
+		t.Fatalf("could not create batch: %s", batchErr.Error())
+	}
+	return batch, mixedBatch{
+		costToRoll:    batch.CostToRoll(),
+		lastRollBlock: batch.LastRollBlock(player.Address),
+		outcome:       batch.Outcome(player.Address, false),
+		rewards:       batch.CurrentRewards(),
+		hasBonus:      batch.HasBonus(player.Address),
+	}
+}
+
+// Checks the results of a mixed batch made at the block of the player's roll, where the outcome cannot be
+// previewed yet.
+func checkMixedBatch(t *testing.T, h *Harness, results mixedBatch, rollBlock *big.Int) {
+	if results.costToRoll.Err != nil || results.costToRoll.Value.Cmp(h.CostToRoll) != 0 {
+		t.Errorf("expected CostToRoll %s, got %v (error: %v)", h.CostToRoll.String(), results.costToRoll.Value, results.costToRoll.Err)
+	}
+	if results.lastRollBlock.Err != nil || results.lastRollBlock.Value.Cmp(rollBlock) != 0 {
+		t.Errorf("expected LastRollBlock %s, got %v (error: %v)", rollBlock.String(), results.lastRollBlock.Value, results.lastRollBlock.Err)
+	}
+	var waitForTick *JackpotJunction.WaitForTickError
+	if !errors.As(results.outcome.Err, &waitForTick) {
+		t.Errorf("expected WaitForTick from outcome in the block of the roll, got %+v (error: %v)", results.outcome.Value, results.outcome.Err)
+	}
+	expected, rewardsErr := h.Contract.CurrentRewards(&bind.CallOpts{BlockNumber: rollBlock})
+	if rewardsErr != nil {
+		t.Fatalf("could not get current rewards: %s", rewardsErr.Error())
+	}
+	rewards := results.rewards.Value
+	if results.rewards.Err != nil || rewards.Small.Cmp(expected.Small) != 0 || rewards.Medium.Cmp(expected.Medium) != 0 || rewards.Large.Cmp(expected.Large) != 0 {
+		t.Errorf("expected rewards %+v, got %+v (error: %v)", expected, rewards, results.rewards.Err)
+	}
+	if results.hasBonus.Err != nil {
+		t.Errorf("unexpected error from hasBonus: %s", results.hasBonus.Err.Error())
+	}
+}
+
+func TestBatchAtPinnedBlock(t *testing.T) {
+	h := New(t, Options{})
+	player := h.Players[0]
+
+	receipt, rollErr := h.Roll(player)
+	if rollErr != nil {
+		t.Fatalf("could not roll: %s", rollErr.Error())
+	}
+	h.Mine(1)
+
+	batch, results := newMixedBatch(t, h, player)
+	executeErr := batch.Execute(context.Background(), h.RPC(), receipt.BlockNumber, 0)
+	if executeErr != nil {
+		t.Fatalf("could not execute batch: %s", executeErr.Error())
+	}
+	if batch.BlockNumber.Cmp(receipt.BlockNumber) != 0 {
+		t.Errorf("expected the batch to be executed at block %s, got %s", receipt.BlockNumber.String(), batch.BlockNumber.String())
+	}
+	checkMixedBatch(t, h, results, receipt.BlockNumber)
+
+	// Without a block number, the batch is executed at the latest block, where the outcome can be previewed.
+	latest, latestResults := newMixedBatch(t, h, player)
+	executeErr = latest.Execute(context.Background(), h.RPC(), nil, 0)
+	if executeErr != nil {
+		t.Fatalf("could not execute batch: %s", executeErr.Error())
+	}
+	if latest.BlockNumber.Uint64() != h.BlockNumber() {
+		t.Errorf("expected the batch to be executed at block %d, got %s", h.BlockNumber(), latest.BlockNumber.String())
+	}
+	entropy, outcome, reward, outcomeErr := h.Contract.Outcome(nil, player.Address, false)
+	if outcomeErr != nil {
+		t.Fatalf("could not preview outcome: %s", outcomeErr.Error())
+	}
+	batched := latestResults.outcome.Value
+	if latestResults.outcome.Err != nil || batched.Entropy.Cmp(entropy) != 0 || batched.Outcome.Cmp(outcome) != 0 || batched.Reward.Cmp(reward) != 0 {
+		t.Errorf("expected outcome (%s, %s, %s), got %+v (error: %v)", entropy.String(), outcome.String(), reward.String(), batched, latestResults.outcome.Err)
+	}
+}
+
+func TestBatchChunking(t *testing.T) {
+	h := New(t, Options{})
+
+	batch, batchErr := JackpotJunction.NewBatch(h.Address)
+	if batchErr != nil {
+		t.Fatalf("could not create batch: %s", batchErr.Error())
+	}
+	lastRollBlocks := make([]*JackpotJunction.Result[*big.Int], len(h.Players))
+	for i, player := range h.Players {
+		lastRollBlocks[i] = batch.LastRollBlock(player.Address)
+	}
+	costToRoll := batch.CostToRoll()
+	costToReroll := batch.CostToReroll()
+	blocksToAct := batch.BlocksToAct()
+	// The player has never rolled, so their deadline has passed.
+	outcome := batch.Outcome(h.Players[0].Address, false)
+
+	client := &countingRPC{Client: h.RPC()}
+	executeErr := batch.Execute(context.Background(), client, nil, 3)
+	if executeErr != nil {
+		t.Fatalf("could not execute batch: %s", executeErr.Error())
+	}
+
+	expectedSizes := []int{3, 3, 1}
+	if len(client.batchSizes) != len(expectedSizes) {
+		t.Fatalf("expected requests of sizes %v, got %v", expectedSizes, client.batchSizes)
+	}
+	for i, size := range expectedSizes {
+		if client.batchSizes[i] != size {
+			t.Errorf("expected requests of sizes %v, got %v", expectedSizes, client.batchSizes)
+			break
+		}
+	}
+
+	for i, lastRollBlock := range lastRollBlocks {
+		if lastRollBlock.Err != nil || lastRollBlock.Value.Sign() != 0 {
+			t.Errorf("player %d: expected LastRollBlock 0, got %v (error: %v)", i, lastRollBlock.Value, lastRollBlock.Err)
+		}
+	}
+	if costToRoll.Err != nil || costToRoll.Value.Cmp(h.CostToRoll) != 0 {
+		t.Errorf("expected CostToRoll %s, got %v (error: %v)", h.CostToRoll.String(), costToRoll.Value, costToRoll.Err)
+	}
+	if costToReroll.Err != nil || costToReroll.Value.Cmp(h.CostToReroll) != 0 {
+		t.Errorf("expected CostToReroll %s, got %v (error: %v)", h.CostToReroll.String(), costToReroll.Value, costToReroll.Err)
+	}
+	if blocksToAct.Err != nil || blocksToAct.Value.Cmp(h.BlocksToAct) != 0 {
+		t.Errorf("expected BlocksToAct %s, got %v (error: %v)", h.BlocksToAct.String(), blocksToAct.Value, blocksToAct.Err)
+	}
+	var deadlineExceeded *JackpotJunction.DeadlineExceededError
+	if !errors.As(outcome.Err, &deadlineExceeded) {
+		t.Errorf("expected DeadlineExceeded from outcome, got %v", outcome.Err)
+	}
+
+	if againErr := batch.Execute(context.Background(), client, nil, 3); !errors.Is(againErr, JackpotJunction.ErrBatchExecuted) {
+		t.Errorf("expected ErrBatchExecuted when executing the batch again, got %v", againErr)
+	}
+}
+
+func TestBatchMulticall3(t *testing.T) {
+	h := New(t, Options{})
+	player := h.Players[0]
+
+	receipt, rollErr := h.Roll(player)
+	if rollErr != nil {
+		t.Fatalf("could not roll: %s", rollErr.Error())
+	}
+	h.Mine(1)
+
+	backend := &multicall3StandIn{Client: h.Client, t: t}
+	batch, results := newMixedBatch(t, h, player)
+	executeErr := batch.ExecuteMulticall3(context.Background(), backend, JackpotJunction.Multicall3Address, receipt.BlockNumber, 2)
+	if executeErr != nil {
+		t.Fatalf("could not execute batch: %s", executeErr.Error())
+	}
+	if backend.aggregates != 3 {
+		t.Errorf("expected %d calls to be split into 3 aggregate3 calls, got %d", batch.Len(), backend.aggregates)
+	}
+	checkMixedBatch(t, h, results, receipt.BlockNumber)
+}
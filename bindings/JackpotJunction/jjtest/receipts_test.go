@@ -0,0 +1,151 @@
+package jjtest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction"
+)
+
+func TestDecodeLogs(t *testing.T) {
+	h := New(t, Options{})
+	player := h.Players[0]
+
+	rollReceipt, rollErr := h.Roll(player)
+	if rollErr != nil {
+		t.Fatalf("could not roll: %s", rollErr.Error())
+	}
+	rollEvents, decodeErr := JackpotJunction.DecodeLogs(h.Address, rollReceipt.Logs)
+	if decodeErr != nil {
+		t.Fatalf("could not decode logs of roll: %s", decodeErr.Error())
+	}
+	if len(rollEvents) != 1 {
+		t.Fatalf("expected 1 event from roll, got %d", len(rollEvents))
+	}
+	if roll, ok := rollEvents[0].(*JackpotJunction.JackpotJunctionRoll); !ok || roll.Player != player.Address || roll.Raw.TxHash != rollReceipt.TxHash {
+		t.Errorf("expected a Roll event for %s, got %+v", player.Address.Hex(), rollEvents[0])
+	}
+
+	// Reroll until accept would award an item, so that accept emits both TransferSingle and Award.
+	var preview JackpotJunction.OutcomeResult
+	for rolls := 0; ; rolls++ {
+		h.Mine(1)
+		var previewErr error
+		preview, previewErr = h.Outcome(player)
+		if previewErr != nil {
+			t.Fatalf("could not preview outcome: %s", previewErr.Error())
+		}
+		if preview.Outcome.Int64() == 1 {
+			break
+		} else if rolls == DefaultMaxRolls {
+			t.Fatalf("no item awarded after %d rolls", rolls)
+		}
+		if _, rerollErr := h.Roll(player); rerollErr != nil {
+			t.Fatalf("could not reroll: %s", rerollErr.Error())
+		}
+	}
+
+	acceptReceipt, acceptErr := h.Send(player, nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.Contract.Accept(opts)
+	})
+	if acceptErr != nil {
+		t.Fatalf("could not accept: %s", acceptErr.Error())
+	}
+
+	// Logs from other contracts, logs with unknown topics, and anonymous logs are skipped.
+	foreign := *acceptReceipt.Logs[0]
+	foreign.Address = common.HexToAddress("0xf0")
+	unknown := *acceptReceipt.Logs[0]
+	unknown.Topics = append([]common.Hash{common.HexToHash("0x1234")}, unknown.Topics[1:]...)
+	anonymous := *acceptReceipt.Logs[0]
+	anonymous.Topics = nil
+	logs := append([]*types.Log{&foreign, &unknown, &anonymous}, acceptReceipt.Logs...)
+
+	acceptEvents, decodeErr := JackpotJunction.DecodeLogs(h.Address, logs)
+	if decodeErr != nil {
+		t.Fatalf("could not decode logs of accept: %s", decodeErr.Error())
+	}
+	if len(acceptEvents) != 2 {
+		t.Fatalf("expected 2 events from accept, got %d: %+v", len(acceptEvents), acceptEvents)
+	}
+	if mint, ok := acceptEvents[0].(*JackpotJunction.JackpotJunctionTransferSingle); !ok || mint.From != (common.Address{}) || mint.To != player.Address || mint.Id.Cmp(preview.Reward) != 0 || mint.Value.Int64() != 1 {
+		t.Errorf("expected a mint of item %s to %s, got %+v", preview.Reward.String(), player.Address.Hex(), acceptEvents[0])
+	}
+	if award, ok := acceptEvents[1].(*JackpotJunction.JackpotJunctionAward); !ok || award.Player != player.Address || award.Outcome.Int64() != 1 || award.Value.Cmp(preview.Reward) != 0 {
+		t.Errorf("expected an Award of item %s to %s, got %+v", preview.Reward.String(), player.Address.Hex(), acceptEvents[1])
+	}
+
+	// Logs are only decoded for the given contract address.
+	if events, _ := JackpotJunction.DecodeLogs(foreign.Address, acceptReceipt.Logs); len(events) != 0 {
+		t.Errorf("expected no events for another contract, got %d", len(events))
+	}
+}
+
+// receiptErrorReader returns the given error for every receipt.
+type receiptErrorReader struct {
+	ethereum.TransactionReader
+	err error
+}
+
+func (reader receiptErrorReader) TransactionReceipt(ctx context.Context, transactionHash common.Hash) (*types.Receipt, error) {
+	return nil, reader.err
+}
+
+func TestWaitForReceipt(t *testing.T) {
+	h := New(t, Options{})
+	player := h.Players[0]
+
+	transaction, rollErr := h.Contract.Roll(h.TransactOpts(player, h.CostToRoll))
+	if rollErr != nil {
+		t.Fatalf("could not roll: %s", rollErr.Error())
+	}
+
+	// The transaction is mined while WaitForReceipt is polling for its receipt.
+	type result struct {
+		receipt *types.Receipt
+		err     error
+	}
+	results := make(chan result, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		receipt, receiptErr := JackpotJunction.WaitForReceipt(ctx, h.Client, transaction.Hash(), 10*time.Millisecond)
+		results <- result{receipt, receiptErr}
+	}()
+	time.Sleep(50 * time.Millisecond)
+	h.Mine(1)
+
+	mined := <-results
+	if mined.err != nil {
+		t.Fatalf("unexpected error: %s", mined.err.Error())
+	}
+	if mined.receipt.TxHash != transaction.Hash() || mined.receipt.Status != types.ReceiptStatusSuccessful {
+		t.Errorf("expected a successful receipt for %s, got %+v", transaction.Hash().Hex(), mined.receipt)
+	}
+
+	// A transaction which is never mined times out.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, timeoutErr := JackpotJunction.WaitForReceipt(ctx, h.Client, common.HexToHash("0x1234"), 10*time.Millisecond)
+	if !errors.Is(timeoutErr, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", timeoutErr)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected WaitForReceipt to return when the context expired, returned after %s", elapsed)
+	}
+
+	// Errors other than ethereum.NotFound are returned without polling again.
+	expectedErr := errors.New("connection refused")
+	_, readerErr := JackpotJunction.WaitForReceipt(context.Background(), receiptErrorReader{err: expectedErr}, transaction.Hash(), time.Hour)
+	if !errors.Is(readerErr, expectedErr) {
+		t.Errorf("expected %v, got %v", expectedErr, readerErr)
+	}
+}
@@ -0,0 +1,412 @@
+package JackpotJunction
+
+// This file is not generated. It provides a way to make many view calls to a JackpotJunction contract in a
+// single request, either as a JSON-RPC batch of eth_call requests or through a Multicall3 contract.
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Multicall3 is deployed at this address on most EVM chains. See https://www.multicall3.com.
+var Multicall3Address common.Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+const multicall3ABI string = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+
+var ErrBatchExecuted error = errors.New("the batch has already been executed")
+var ErrNotExecuted error = errors.New("the batch has not been executed yet")
+
+// Result holds the result of a single call in a Batch. It is filled in when the batch is executed. If the
+// call reverted, Err is the decoded revert error (see DecodeRevert).
+type Result[T any] struct {
+	Value T
+	Err   error
+}
+
+// CurrentRewardsResult is the result of currentRewards.
+type CurrentRewardsResult struct {
+	Small  *big.Int
+	Medium *big.Int
+	Large  *big.Int
+}
+
+// GeneraResult is the result of genera.
+type GeneraResult struct {
+	ItemType    *big.Int
+	TerrainType *big.Int
+	Tier        *big.Int
+}
+
+// OutcomeResult is the result of outcome.
+type OutcomeResult struct {
+	Entropy *big.Int
+	Outcome *big.Int
+	Reward  *big.Int
+}
+
+type batchCall struct {
+	data    []byte
+	packErr error
+	// Fills in the result of the call from its return data or error.
+	setResult func(output []byte, err error)
+}
+
+// Batch collects view calls to a JackpotJunction contract and executes them together, at a single block.
+// Each method adds a call to the batch and returns a Result which is filled in by Execute or
+// ExecuteMulticall3:
+//
+//	batch, _ := NewBatch(contractAddress)
+//	covers := make([]*Result[*big.Int], len(players))
+//	for i, player := range players {
+//		covers[i] = batch.EquippedCover(player)
+//	}
+//	err := batch.Execute(ctx, client.Client(), nil, 0)
+type Batch struct {
+	Contract common.Address
+	abi      *abi.ABI
+	calls    []batchCall
+	executed bool
+	// BlockNumber is the block at which the calls were executed.
+	BlockNumber *big.Int
+}
+
+// NewBatch creates an empty batch of calls to the JackpotJunction contract at the given address.
+func NewBatch(contractAddress common.Address) (*Batch, error) {
+	contractABI, abiErr := JackpotJunctionMetaData.GetAbi()
+	if abiErr != nil {
+		return nil, abiErr
+	}
+	return &Batch{Contract: contractAddress, abi: contractABI}, nil
+}
+
+// Len returns the number of calls in the batch.
+func (batch *Batch) Len() int {
+	return len(batch.calls)
+}
+
+// Adds a call whose outputs are converted into a T by convert.
+func addCall[T any](batch *Batch, convert func(out []interface{}) T, method string, args ...interface{}) *Result[T] {
+	result := &Result[T]{Err: ErrNotExecuted}
+	data, packErr := batch.abi.Pack(method, args...)
+	batch.calls = append(batch.calls, batchCall{
+		data:    data,
+		packErr: packErr,
+		setResult: func(output []byte, err error) {
+			if err != nil {
+				result.Err = err
+				return
+			}
+			out, unpackErr := batch.abi.Unpack(method, output)
+			if unpackErr != nil {
+				result.Err = unpackErr
+				return
+			}
+			result.Value = convert(out)
+			result.Err = nil
+		},
+	})
+	return result
+}
+
+// Adds a call with a single output of type T.
+func addSingle[T any](batch *Batch, method string, args ...interface{}) *Result[T] {
+	return addCall(batch, func(out []interface{}) T {
+		return *abi.ConvertType(out[0], new(T)).(*T)
+	}, method, args...)
+}
+
+func convertBigInts(out []interface{}) [3]*big.Int {
+	var converted [3]*big.Int
+	for i := range converted {
+		converted[i] = *abi.ConvertType(out[i], new(*big.Int)).(**big.Int)
+	}
+	return converted
+}
+
+func (batch *Batch) BlocksToAct() *Result[*big.Int] {
+	return addSingle[*big.Int](batch, "BlocksToAct")
+}
+
+func (batch *Batch) CostToReroll() *Result[*big.Int] {
+	return addSingle[*big.Int](batch, "CostToReroll")
+}
+
+func (batch *Batch) CostToRoll() *Result[*big.Int] {
+	return addSingle[*big.Int](batch, "CostToRoll")
+}
+
+func (batch *Batch) CurrentTier(itemType, terrainType *big.Int) *Result[*big.Int] {
+	return addSingle[*big.Int](batch, "CurrentTier", itemType, terrainType)
+}
+
+func (batch *Batch) EquippedBeasts(player common.Address) *Result[*big.Int] {
+	return addSingle[*big.Int](batch, "EquippedBeasts", player)
+}
+
+func (batch *Batch) EquippedBody(player common.Address) *Result[*big.Int] {
+	return addSingle[*big.Int](batch, "EquippedBody", player)
+}
+
+func (batch *Batch) EquippedCover(player common.Address) *Result[*big.Int] {
+	return addSingle[*big.Int](batch, "EquippedCover", player)
+}
+
+func (batch *Batch) EquippedWheels(player common.Address) *Result[*big.Int] {
+	return addSingle[*big.Int](batch, "EquippedWheels", player)
+}
+
+func (batch *Batch) ImprovedOutcomesCumulativeMass(index *big.Int) *Result[*big.Int] {
+	return addSingle[*big.Int](batch, "ImprovedOutcomesCumulativeMass", index)
+}
+
+func (batch *Batch) LastRollBlock(player common.Address) *Result[*big.Int] {
+	return addSingle[*big.Int](batch, "LastRollBlock", player)
+}
+
+func (batch *Batch) UnmodifiedOutcomesCumulativeMass(index *big.Int) *Result[*big.Int] {
+	return addSingle[*big.Int](batch, "UnmodifiedOutcomesCumulativeMass", index)
+}
+
+func (batch *Batch) BalanceOf(account common.Address, id *big.Int) *Result[*big.Int] {
+	return addSingle[*big.Int](batch, "balanceOf", account, id)
+}
+
+func (batch *Batch) BalanceOfBatch(accounts []common.Address, ids []*big.Int) *Result[[]*big.Int] {
+	return addSingle[[]*big.Int](batch, "balanceOfBatch", accounts, ids)
+}
+
+func (batch *Batch) CurrentRewards() *Result[CurrentRewardsResult] {
+	return addCall(batch, func(out []interface{}) CurrentRewardsResult {
+		converted := convertBigInts(out)
+		return CurrentRewardsResult{Small: converted[0], Medium: converted[1], Large: converted[2]}
+	}, "currentRewards")
+}
+
+func (batch *Batch) Genera(poolID *big.Int) *Result[GeneraResult] {
+	return addCall(batch, func(out []interface{}) GeneraResult {
+		converted := convertBigInts(out)
+		return GeneraResult{ItemType: converted[0], TerrainType: converted[1], Tier: converted[2]}
+	}, "genera", poolID)
+}
+
+func (batch *Batch) HasBonus(player common.Address) *Result[bool] {
+	return addSingle[bool](batch, "hasBonus", player)
+}
+
+func (batch *Batch) IsApprovedForAll(account, operator common.Address) *Result[bool] {
+	return addSingle[bool](batch, "isApprovedForAll", account, operator)
+}
+
+func (batch *Batch) Outcome(player common.Address, bonus bool) *Result[OutcomeResult] {
+	return addCall(batch, func(out []interface{}) OutcomeResult {
+		converted := convertBigInts(out)
+		return OutcomeResult{Entropy: converted[0], Outcome: converted[1], Reward: converted[2]}
+	}, "outcome", player, bonus)
+}
+
+func (batch *Batch) PoolMetadata(poolID *big.Int) *Result[[]byte] {
+	return addSingle[[]byte](batch, "poolMetadata", poolID)
+}
+
+func (batch *Batch) SampleImprovedOutcomesCumulativeMass(entropy *big.Int) *Result[*big.Int] {
+	return addSingle[*big.Int](batch, "sampleImprovedOutcomesCumulativeMass", entropy)
+}
+
+func (batch *Batch) SampleUnmodifiedOutcomeCumulativeMass(entropy *big.Int) *Result[*big.Int] {
+	return addSingle[*big.Int](batch, "sampleUnmodifiedOutcomeCumulativeMass", entropy)
+}
+
+func (batch *Batch) SupportsInterface(interfaceID [4]byte) *Result[bool] {
+	return addSingle[bool](batch, "supportsInterface", interfaceID)
+}
+
+func (batch *Batch) Uri(poolID *big.Int) *Result[string] {
+	return addSingle[string](batch, "uri", poolID)
+}
+
+// Marks the batch as executed and fails the calls whose arguments could not be packed. Returns the indices
+// of the calls that still need to be made.
+func (batch *Batch) start() ([]int, error) {
+	if batch.executed {
+		return nil, ErrBatchExecuted
+	}
+	batch.executed = true
+
+	pending := []int{}
+	for i, call := range batch.calls {
+		if call.packErr != nil {
+			call.setResult(nil, call.packErr)
+			continue
+		}
+		pending = append(pending, i)
+	}
+	return pending, nil
+}
+
+// BatchBackend is the subset of the JSON-RPC client that Execute needs. It is satisfied by *rpc.Client (which
+// you can get from an *ethclient.Client using its Client method).
+type BatchBackend interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
+}
+
+// Execute makes all the calls in the batch as a JSON-RPC batch of eth_call requests, sending at most
+// maxBatchSize calls per request (all of them in one request if maxBatchSize is 0). All calls are made at
+// the given block. If blockNumber is nil, the current block number is requested first so that all calls see
+// the same state even if the batch is split into several requests.
+//
+// The returned error is only non-nil if the requests themselves failed. Errors for individual calls (such
+// as reverts) are reported in their results.
+func (batch *Batch) Execute(ctx context.Context, client BatchBackend, blockNumber *big.Int, maxBatchSize int) error {
+	pending, startErr := batch.start()
+	if startErr != nil {
+		return startErr
+	}
+
+	if blockNumber == nil {
+		var head hexutil.Big
+		headErr := client.CallContext(ctx, &head, "eth_blockNumber")
+		if headErr != nil {
+			return headErr
+		}
+		blockNumber = head.ToInt()
+	}
+	batch.BlockNumber = blockNumber
+
+	if maxBatchSize <= 0 {
+		maxBatchSize = len(pending)
+	}
+
+	for chunkStart := 0; chunkStart < len(pending); chunkStart += maxBatchSize {
+		chunkEnd := chunkStart + maxBatchSize
+		if chunkEnd > len(pending) {
+			chunkEnd = len(pending)
+		}
+
+		elems := make([]rpc.BatchElem, chunkEnd-chunkStart)
+		outputs := make([]hexutil.Bytes, len(elems))
+		for i, callIndex := range pending[chunkStart:chunkEnd] {
+			elems[i] = rpc.BatchElem{
+				Method: "eth_call",
+				Args: []interface{}{
+					map[string]interface{}{
+						"to":   batch.Contract,
+						"data": hexutil.Bytes(batch.calls[callIndex].data),
+					},
+					hexutil.EncodeBig(blockNumber),
+				},
+				Result: &outputs[i],
+			}
+		}
+
+		batchErr := client.BatchCallContext(ctx, elems)
+		if batchErr != nil {
+			return batchErr
+		}
+
+		for i, callIndex := range pending[chunkStart:chunkEnd] {
+			batch.calls[callIndex].setResult(outputs[i], DecodeError(elems[i].Error))
+		}
+	}
+
+	return nil
+}
+
+// MulticallBackend is the subset of the Ethereum JSON-RPC API that ExecuteMulticall3 needs. It is satisfied
+// by *ethclient.Client.
+type MulticallBackend interface {
+	bind.ContractCaller
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// ExecuteMulticall3 makes all the calls in the batch through the aggregate3 method of the Multicall3
+// contract at the given address (usually Multicall3Address), with at most maxCalls calls in each eth_call
+// (all of them in one eth_call if maxCalls is 0). Block numbers and errors are handled as in Execute.
+func (batch *Batch) ExecuteMulticall3(ctx context.Context, backend MulticallBackend, multicallAddress common.Address, blockNumber *big.Int, maxCalls int) error {
+	pending, startErr := batch.start()
+	if startErr != nil {
+		return startErr
+	}
+
+	multicallABI, abiErr := abi.JSON(strings.NewReader(multicall3ABI))
+	if abiErr != nil {
+		return abiErr
+	}
+
+	if blockNumber == nil {
+		head, headErr := backend.BlockNumber(ctx)
+		if headErr != nil {
+			return headErr
+		}
+		blockNumber = new(big.Int).SetUint64(head)
+	}
+	batch.BlockNumber = blockNumber
+
+	if maxCalls <= 0 {
+		maxCalls = len(pending)
+	}
+
+	type call3 struct {
+		Target       common.Address
+		AllowFailure bool
+		CallData     []byte
+	}
+
+	for chunkStart := 0; chunkStart < len(pending); chunkStart += maxCalls {
+		chunkEnd := chunkStart + maxCalls
+		if chunkEnd > len(pending) {
+			chunkEnd = len(pending)
+		}
+
+		calls := make([]call3, chunkEnd-chunkStart)
+		for i, callIndex := range pending[chunkStart:chunkEnd] {
+			calls[i] = call3{Target: batch.Contract, AllowFailure: true, CallData: batch.calls[callIndex].data}
+		}
+
+		input, packErr := multicallABI.Pack("aggregate3", calls)
+		if packErr != nil {
+			return packErr
+		}
+
+		output, callErr := backend.CallContract(ctx, ethereum.CallMsg{To: &multicallAddress, Data: input}, blockNumber)
+		if callErr != nil {
+			return DecodeError(callErr)
+		}
+
+		out, unpackErr := multicallABI.Unpack("aggregate3", output)
+		if unpackErr != nil {
+			return unpackErr
+		}
+
+		results := *abi.ConvertType(out[0], new([]struct {
+			Success    bool
+			ReturnData []byte
+		})).(*[]struct {
+			Success    bool
+			ReturnData []byte
+		})
+
+		if len(results) != len(calls) {
+			return errors.New("Multicall3 returned an unexpected number of results")
+		}
+
+		for i, callIndex := range pending[chunkStart:chunkEnd] {
+			if results[i].Success {
+				batch.calls[callIndex].setResult(results[i].ReturnData, nil)
+			} else {
+				batch.calls[callIndex].setResult(nil, DecodeRevert(results[i].ReturnData))
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package JackpotJunction
+
+// This file is not generated. It provides helpers to wait for JackpotJunction transactions to be mined and
+// to decode the events in their receipts.
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// WaitForReceipt polls the JSON-RPC API for the receipt of the transaction with the given hash until the
+// transaction is mined or the context expires. If the context expires, it returns the error of the context
+// (even if the context expired during a request, which the client may report as a transport error).
+func WaitForReceipt(ctx context.Context, client ethereum.TransactionReader, transactionHash common.Hash, pollInterval time.Duration) (*types.Receipt, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		receipt, receiptErr := client.TransactionReceipt(ctx, transactionHash)
+		if receiptErr == nil {
+			return receipt, nil
+		} else if contextDone(ctx) {
+			return nil, ctx.Err()
+		} else if !errors.Is(receiptErr, ethereum.NotFound) {
+			return nil, receiptErr
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reports whether the context is done, or will be done imminently because its deadline has passed (clients set
+// their I/O deadlines from the context, so a request may fail before the context expires).
+func contextDone(ctx context.Context) bool {
+	if deadline, ok := ctx.Deadline(); ok && !time.Now().Before(deadline) {
+		<-ctx.Done()
+	}
+	return ctx.Err() != nil
+}
+
+// DecodeLogs decodes the events emitted by the JackpotJunction contract at the given address. Each element
+// of the result is one of *JackpotJunctionApprovalForAll, *JackpotJunctionAward, *JackpotJunctionRoll,
+// *JackpotJunctionTierUnlocked, *JackpotJunctionTransferBatch, *JackpotJunctionTransferSingle, or
+// *JackpotJunctionURI. Logs emitted by other contracts are skipped.
+func DecodeLogs(contractAddress common.Address, logs []*types.Log) ([]interface{}, error) {
+	filterer, filtererErr := NewJackpotJunctionFilterer(contractAddress, nil)
+	if filtererErr != nil {
+		return nil, filtererErr
+	}
+
+	contractABI, abiErr := JackpotJunctionMetaData.GetAbi()
+	if abiErr != nil {
+		return nil, abiErr
+	}
+
+	events := []interface{}{}
+	for _, log := range logs {
+		if log.Address != contractAddress || len(log.Topics) == 0 {
+			continue
+		}
+
+		var event interface{}
+		var parseErr error
+		switch log.Topics[0] {
+		case contractABI.Events["ApprovalForAll"].ID:
+			event, parseErr = filterer.ParseApprovalForAll(*log)
+		case contractABI.Events["Award"].ID:
+			event, parseErr = filterer.ParseAward(*log)
+		case contractABI.Events["Roll"].ID:
+			event, parseErr = filterer.ParseRoll(*log)
+		case contractABI.Events["TierUnlocked"].ID:
+			event, parseErr = filterer.ParseTierUnlocked(*log)
+		case contractABI.Events["TransferBatch"].ID:
+			event, parseErr = filterer.ParseTransferBatch(*log)
+		case contractABI.Events["TransferSingle"].ID:
+			event, parseErr = filterer.ParseTransferSingle(*log)
+		case contractABI.Events["URI"].ID:
+			event, parseErr = filterer.ParseURI(*log)
+		default:
+			continue
+		}
+
+		if parseErr != nil {
+			return events, parseErr
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
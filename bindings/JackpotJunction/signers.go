@@ -0,0 +1,307 @@
+package JackpotJunction
+
+// This file is not generated. It provides the Signer abstraction which the jj CLI uses to sign transactions,
+// with implementations for keystore files, raw private keys, mnemonics (with HD derivation paths) and
+// external signers which speak the Clef JSON-RPC API.
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/tyler-smith/go-bip39"
+)
+
+var ErrInvalidSignerSpec error = errors.New("invalid signer: must be one of keystore:<path>, key-env:<variable>, key-file:<path>, mnemonic-env:<variable>[#<derivation path>], mnemonic-file:<path>[#<derivation path>], external:<url>#<address>")
+var ErrInvalidDerivedKey error = errors.New("derivation path leads to an invalid key -- please use the next index")
+var ErrSignerMismatch error = errors.New("external signer returned a transaction which does not match the request")
+
+// DefaultDerivationPath is the derivation path of the first account in most Ethereum wallets.
+const DefaultDerivationPath string = "m/44'/60'/0'/0/0"
+
+// Signer signs transactions on behalf of a single account.
+type Signer interface {
+	Address() common.Address
+	SignTransaction(ctx context.Context, transaction *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
+// NewSignerTransactOpts creates transaction options which sign with the given signer. Like the options
+// created by bind.NewKeyedTransactorWithChainID, they can be modified (e.g. with
+// SetTransactionParametersFromArgs) before they are used.
+func NewSignerTransactOpts(ctx context.Context, signer Signer, chainID *big.Int) *bind.TransactOpts {
+	return &bind.TransactOpts{
+		From:    signer.Address(),
+		Context: ctx,
+		Signer: func(address common.Address, transaction *types.Transaction) (*types.Transaction, error) {
+			if address != signer.Address() {
+				return nil, bind.ErrNotAuthorized
+			}
+			return signer.SignTransaction(ctx, transaction, chainID)
+		},
+	}
+}
+
+// PrivateKeySigner signs transactions with a private key held in memory.
+type PrivateKeySigner struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+func NewPrivateKeySigner(key *ecdsa.PrivateKey) *PrivateKeySigner {
+	return &PrivateKeySigner{key: key, address: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+func (signer *PrivateKeySigner) Address() common.Address {
+	return signer.address
+}
+
+func (signer *PrivateKeySigner) SignTransaction(ctx context.Context, transaction *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return types.SignTx(transaction, types.LatestSignerForChainID(chainID), signer.key)
+}
+
+// KeystoreSigner signs with the key in an encrypted JSON keystore file. If password is empty, the user is
+// prompted for it (see KeyFromFile).
+func KeystoreSigner(keystoreFile, password string) (*PrivateKeySigner, error) {
+	key, keyErr := KeyFromFile(keystoreFile, password)
+	if keyErr != nil {
+		return nil, keyErr
+	}
+	return NewPrivateKeySigner(key.PrivateKey), nil
+}
+
+// PrivateKeySignerFromHex signs with a raw, hex encoded private key (with or without the 0x prefix).
+func PrivateKeySignerFromHex(rawKey string) (*PrivateKeySigner, error) {
+	key, keyErr := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(rawKey), "0x"))
+	if keyErr != nil {
+		return nil, fmt.Errorf("invalid private key: %s", keyErr.Error())
+	}
+	return NewPrivateKeySigner(key), nil
+}
+
+// PrivateKeySignerFromEnv signs with the raw private key in the given environment variable.
+func PrivateKeySignerFromEnv(variable string) (*PrivateKeySigner, error) {
+	rawKey := os.Getenv(variable)
+	if rawKey == "" {
+		return nil, fmt.Errorf("environment variable %s is not set", variable)
+	}
+	return PrivateKeySignerFromHex(rawKey)
+}
+
+// PrivateKeySignerFromFile signs with the raw private key in the given file.
+func PrivateKeySignerFromFile(path string) (*PrivateKeySigner, error) {
+	contents, readErr := os.ReadFile(path)
+	if readErr != nil {
+		return nil, readErr
+	}
+	return PrivateKeySignerFromHex(string(contents))
+}
+
+// MnemonicSigner signs with the key derived from a BIP39 mnemonic (and optional passphrase) along a BIP32
+// derivation path, e.g. DefaultDerivationPath.
+func MnemonicSigner(mnemonic, passphrase, derivationPath string) (*PrivateKeySigner, error) {
+	path, pathErr := accounts.ParseDerivationPath(derivationPath)
+	if pathErr != nil {
+		return nil, pathErr
+	}
+
+	seed, seedErr := bip39.NewSeedWithErrorChecking(strings.Join(strings.Fields(mnemonic), " "), passphrase)
+	if seedErr != nil {
+		return nil, fmt.Errorf("invalid mnemonic: %s", seedErr.Error())
+	}
+
+	key, keyErr := deriveKey(seed, path)
+	if keyErr != nil {
+		return nil, keyErr
+	}
+	return NewPrivateKeySigner(key), nil
+}
+
+// Derives the private key at the given path from a BIP32 seed.
+func deriveKey(seed []byte, path accounts.DerivationPath) (*ecdsa.PrivateKey, error) {
+	curveOrder := crypto.S256().Params().N
+
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	digest := mac.Sum(nil)
+	key, chainCode := new(big.Int).SetBytes(digest[:32]), digest[32:]
+	if key.Sign() == 0 || key.Cmp(curveOrder) >= 0 {
+		return nil, ErrInvalidDerivedKey
+	}
+
+	for _, index := range path {
+		var data []byte
+		if index >= 0x80000000 {
+			data = append([]byte{0}, common.LeftPadBytes(key.Bytes(), 32)...)
+		} else {
+			privateKey, toECDSAErr := crypto.ToECDSA(common.LeftPadBytes(key.Bytes(), 32))
+			if toECDSAErr != nil {
+				return nil, toECDSAErr
+			}
+			data = crypto.CompressPubkey(&privateKey.PublicKey)
+		}
+		data = append(data, byte(index>>24), byte(index>>16), byte(index>>8), byte(index))
+
+		mac := hmac.New(sha512.New, chainCode)
+		mac.Write(data)
+		digest := mac.Sum(nil)
+
+		tweak := new(big.Int).SetBytes(digest[:32])
+		if tweak.Cmp(curveOrder) >= 0 {
+			return nil, ErrInvalidDerivedKey
+		}
+		key = new(big.Int).Mod(new(big.Int).Add(tweak, key), curveOrder)
+		if key.Sign() == 0 {
+			return nil, ErrInvalidDerivedKey
+		}
+		chainCode = digest[32:]
+	}
+
+	return crypto.ToECDSA(common.LeftPadBytes(key.Bytes(), 32))
+}
+
+// ExternalSigner asks an external signer to sign transactions over JSON-RPC using the account_signTransaction
+// method of the Clef API. The signer may require the user to approve each transaction.
+type ExternalSigner struct {
+	client  *rpc.Client
+	account common.Address
+}
+
+// NewExternalSigner connects to the external signer at the given URL (HTTP, WebSocket or IPC path), which
+// signs transactions on behalf of the given account.
+func NewExternalSigner(ctx context.Context, url string, account common.Address) (*ExternalSigner, error) {
+	client, dialErr := rpc.DialContext(ctx, url)
+	if dialErr != nil {
+		return nil, dialErr
+	}
+	return &ExternalSigner{client: client, account: account}, nil
+}
+
+func (signer *ExternalSigner) Address() common.Address {
+	return signer.account
+}
+
+func (signer *ExternalSigner) SignTransaction(ctx context.Context, transaction *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	args := map[string]interface{}{
+		"from":    common.NewMixedcaseAddress(signer.account),
+		"gas":     hexutil.Uint64(transaction.Gas()),
+		"value":   (*hexutil.Big)(transaction.Value()),
+		"nonce":   hexutil.Uint64(transaction.Nonce()),
+		"input":   hexutil.Bytes(transaction.Data()),
+		"chainId": (*hexutil.Big)(chainID),
+	}
+	if transaction.To() != nil {
+		args["to"] = common.NewMixedcaseAddress(*transaction.To())
+	}
+	if transaction.Type() == types.LegacyTxType {
+		args["gasPrice"] = (*hexutil.Big)(transaction.GasPrice())
+	} else {
+		args["maxFeePerGas"] = (*hexutil.Big)(transaction.GasFeeCap())
+		args["maxPriorityFeePerGas"] = (*hexutil.Big)(transaction.GasTipCap())
+	}
+
+	var response struct {
+		Raw hexutil.Bytes `json:"raw"`
+	}
+	callErr := signer.client.CallContext(ctx, &response, "account_signTransaction", args)
+	if callErr != nil {
+		return nil, callErr
+	}
+
+	signed := new(types.Transaction)
+	unmarshalErr := signed.UnmarshalBinary(response.Raw)
+	if unmarshalErr != nil {
+		return nil, unmarshalErr
+	}
+
+	// The signer may not modify the transaction, and must sign it with the requested account.
+	sender, senderErr := types.Sender(types.LatestSignerForChainID(chainID), signed)
+	if senderErr != nil {
+		return nil, senderErr
+	}
+	if sender != signer.account || signed.Nonce() != transaction.Nonce() || signed.Gas() != transaction.Gas() || signed.Value().Cmp(transaction.Value()) != 0 || !bytes.Equal(signed.Data(), transaction.Data()) || !addressesEqual(signed.To(), transaction.To()) {
+		return nil, ErrSignerMismatch
+	}
+
+	return signed, nil
+}
+
+// Close closes the connection to the external signer.
+func (signer *ExternalSigner) Close() {
+	signer.client.Close()
+}
+
+func addressesEqual(a, b *common.Address) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// ParseSigner creates a signer from a specification of the form <kind>:<source>:
+//
+//	keystore:<path>                    encrypted JSON keystore, unlocked with password (prompts if it is empty)
+//	key-env:<variable>                 hex encoded private key in an environment variable
+//	key-file:<path>                    hex encoded private key in a file
+//	mnemonic-env:<variable>[#<path>]   BIP39 mnemonic in an environment variable, with an optional derivation path
+//	mnemonic-file:<path>[#<path>]      BIP39 mnemonic in a file, with an optional derivation path
+//	external:<url>#<address>           Clef compatible external signer, signing for the given account
+//
+// The derivation path defaults to DefaultDerivationPath. The passphrase for mnemonics is taken from password.
+func ParseSigner(ctx context.Context, spec, password string) (Signer, error) {
+	kind, source, found := strings.Cut(spec, ":")
+	if !found || source == "" {
+		return nil, ErrInvalidSignerSpec
+	}
+
+	switch kind {
+	case "keystore":
+		return KeystoreSigner(source, password)
+	case "key-env":
+		return PrivateKeySignerFromEnv(source)
+	case "key-file":
+		return PrivateKeySignerFromFile(source)
+	case "mnemonic-env", "mnemonic-file":
+		source, derivationPath, found := strings.Cut(source, "#")
+		if !found {
+			derivationPath = DefaultDerivationPath
+		}
+
+		var mnemonic string
+		if kind == "mnemonic-env" {
+			mnemonic = os.Getenv(source)
+			if mnemonic == "" {
+				return nil, fmt.Errorf("environment variable %s is not set", source)
+			}
+		} else {
+			contents, readErr := os.ReadFile(source)
+			if readErr != nil {
+				return nil, readErr
+			}
+			mnemonic = string(contents)
+		}
+
+		return MnemonicSigner(mnemonic, password, derivationPath)
+	case "external":
+		url, accountRaw, found := strings.Cut(source, "#")
+		if !found || !common.IsHexAddress(accountRaw) {
+			return nil, errors.New("external signers must be specified as external:<url>#<address>")
+		}
+		return NewExternalSigner(ctx, url, common.HexToAddress(accountRaw))
+	}
+
+	return nil, ErrInvalidSignerSpec
+}
@@ -0,0 +1,126 @@
+package JackpotJunction
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const testMnemonic string = "test test test test test test test test test test test junk"
+
+func TestMnemonicSigner(t *testing.T) {
+	cases := map[string]common.Address{
+		"m/44'/60'/0'/0/0": common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"),
+		"m/44'/60'/0'/0/1": common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8"),
+	}
+	for derivationPath, expected := range cases {
+		signer, signerErr := MnemonicSigner(testMnemonic, "", derivationPath)
+		if signerErr != nil {
+			t.Fatalf("unexpected error for %s: %s", derivationPath, signerErr.Error())
+		}
+		if signer.Address() != expected {
+			t.Errorf("derived %s at %s, expected %s", signer.Address().Hex(), derivationPath, expected.Hex())
+		}
+	}
+
+	_, invalidErr := MnemonicSigner("test test test test test test test test test test test test", "", DefaultDerivationPath)
+	if invalidErr == nil {
+		t.Errorf("expected an error for a mnemonic with an invalid checksum")
+	}
+}
+
+// Stands in for Clef. It signs the requested transaction with key, after applying tamper to it.
+type accountService struct {
+	key    *PrivateKeySigner
+	tamper func(*types.DynamicFeeTx)
+}
+
+type signTxArgs struct {
+	From                 common.MixedcaseAddress  `json:"from"`
+	To                   *common.MixedcaseAddress `json:"to"`
+	Gas                  hexutil.Uint64           `json:"gas"`
+	MaxFeePerGas         *hexutil.Big             `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big             `json:"maxPriorityFeePerGas"`
+	Value                hexutil.Big              `json:"value"`
+	Nonce                hexutil.Uint64           `json:"nonce"`
+	Input                hexutil.Bytes            `json:"input"`
+	ChainID              *hexutil.Big             `json:"chainId"`
+}
+
+func (s *accountService) SignTransaction(args signTxArgs, methodSelector *string) (map[string]interface{}, error) {
+	if args.From.Address() != s.key.Address() {
+		return nil, errors.New("unknown account")
+	}
+	to := args.To.Address()
+	unsigned := &types.DynamicFeeTx{
+		ChainID:   args.ChainID.ToInt(),
+		Nonce:     uint64(args.Nonce),
+		Gas:       uint64(args.Gas),
+		GasFeeCap: args.MaxFeePerGas.ToInt(),
+		GasTipCap: args.MaxPriorityFeePerGas.ToInt(),
+		To:        &to,
+		Value:     args.Value.ToInt(),
+		Data:      args.Input,
+	}
+	if s.tamper != nil {
+		s.tamper(unsigned)
+	}
+
+	signed, signErr := s.key.SignTransaction(context.Background(), types.NewTx(unsigned), args.ChainID.ToInt())
+	if signErr != nil {
+		return nil, signErr
+	}
+	raw, marshalErr := signed.MarshalBinary()
+	return map[string]interface{}{"raw": hexutil.Bytes(raw)}, marshalErr
+}
+
+func TestExternalSigner(t *testing.T) {
+	key, _ := MnemonicSigner(testMnemonic, "", DefaultDerivationPath)
+	service := &accountService{key: key}
+	server := rpc.NewServer()
+	server.RegisterName("account", service)
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	signer, signerErr := ParseSigner(context.Background(), "external:"+httpServer.URL+"#"+key.Address().Hex(), "")
+	if signerErr != nil {
+		t.Fatalf("could not create external signer: %s", signerErr.Error())
+	}
+
+	chainID := big.NewInt(1337)
+	contract := common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	transaction := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     3,
+		Gas:       100000,
+		GasFeeCap: big.NewInt(2000000000),
+		GasTipCap: big.NewInt(1000000000),
+		To:        &contract,
+		Value:     big.NewInt(100),
+		Data:      common.FromHex("0x0c5d4b7a"),
+	})
+
+	signed, signErr := signer.SignTransaction(context.Background(), transaction, chainID)
+	if signErr != nil {
+		t.Fatalf("unexpected error: %s", signErr.Error())
+	}
+	sender, _ := types.Sender(types.LatestSignerForChainID(chainID), signed)
+	if sender != key.Address() || signed.Nonce() != 3 || signed.Value().Cmp(big.NewInt(100)) != 0 {
+		t.Fatalf("unexpected signed transaction from %s with nonce %d and value %s", sender.Hex(), signed.Nonce(), signed.Value().String())
+	}
+
+	service.tamper = func(unsigned *types.DynamicFeeTx) {
+		unsigned.Value = big.NewInt(1000000)
+	}
+	_, tamperedErr := signer.SignTransaction(context.Background(), transaction, chainID)
+	if !errors.Is(tamperedErr, ErrSignerMismatch) {
+		t.Fatalf("expected ErrSignerMismatch for a tampered transaction, got %v", tamperedErr)
+	}
+}
@@ -0,0 +1,156 @@
+package analytics
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction"
+)
+
+// Roll represents a Roll event emitted by the JackpotJunction contract.
+type Roll struct {
+	BlockNumber uint64
+	LogIndex    uint64
+	Player      common.Address
+}
+
+// Award represents an Award event emitted by the JackpotJunction contract.
+type Award struct {
+	BlockNumber uint64
+	LogIndex    uint64
+	Player      common.Address
+	Outcome     uint64
+	Value       *big.Int
+}
+
+// History is the sequence of Roll and Award events emitted by a JackpotJunction contract, ordered by block
+// number and log index.
+type History struct {
+	Rolls  []Roll
+	Awards []Award
+}
+
+func (history *History) sort() {
+	sort.Slice(history.Rolls, func(i, j int) bool {
+		if history.Rolls[i].BlockNumber != history.Rolls[j].BlockNumber {
+			return history.Rolls[i].BlockNumber < history.Rolls[j].BlockNumber
+		}
+		return history.Rolls[i].LogIndex < history.Rolls[j].LogIndex
+	})
+	sort.Slice(history.Awards, func(i, j int) bool {
+		if history.Awards[i].BlockNumber != history.Awards[j].BlockNumber {
+			return history.Awards[i].BlockNumber < history.Awards[j].BlockNumber
+		}
+		return history.Awards[i].LogIndex < history.Awards[j].LogIndex
+	})
+}
+
+// FromIndex loads the Roll and Award history in the given (inclusive) range of blocks from a database
+// populated by jj index. If end is 0, there is no upper bound.
+func FromIndex(db *sql.DB, start, end uint64) (History, error) {
+	history := History{Rolls: []Roll{}, Awards: []Award{}}
+	if end == 0 {
+		end = 1<<63 - 1
+	}
+
+	rollRows, rollsErr := db.Query("SELECT block_number, log_index, player FROM rolls WHERE block_number >= ? AND block_number <= ?", start, end)
+	if rollsErr != nil {
+		return history, rollsErr
+	}
+	defer rollRows.Close()
+	for rollRows.Next() {
+		var roll Roll
+		var player string
+		scanErr := rollRows.Scan(&roll.BlockNumber, &roll.LogIndex, &player)
+		if scanErr != nil {
+			return history, scanErr
+		}
+		roll.Player = common.HexToAddress(player)
+		history.Rolls = append(history.Rolls, roll)
+	}
+	if rollRows.Err() != nil {
+		return history, rollRows.Err()
+	}
+
+	awardRows, awardsErr := db.Query("SELECT block_number, log_index, player, outcome, value FROM awards WHERE block_number >= ? AND block_number <= ?", start, end)
+	if awardsErr != nil {
+		return history, awardsErr
+	}
+	defer awardRows.Close()
+	for awardRows.Next() {
+		var award Award
+		var player, value string
+		scanErr := awardRows.Scan(&award.BlockNumber, &award.LogIndex, &player, &award.Outcome, &value)
+		if scanErr != nil {
+			return history, scanErr
+		}
+		award.Player = common.HexToAddress(player)
+		award.Value, _ = new(big.Int).SetString(value, 10)
+		history.Awards = append(history.Awards, award)
+	}
+	if awardRows.Err() != nil {
+		return history, awardRows.Err()
+	}
+
+	history.sort()
+	return history, nil
+}
+
+// FromLogs loads the Roll and Award history in the given (inclusive) range of blocks directly from the
+// JSON-RPC API, requesting logs for chunkSize blocks at a time.
+func FromLogs(ctx context.Context, filterer *JackpotJunction.JackpotJunctionFilterer, start, end, chunkSize uint64) (History, error) {
+	history := History{Rolls: []Roll{}, Awards: []Award{}}
+	if chunkSize == 0 {
+		chunkSize = 1
+	}
+
+	for fromBlock := start; fromBlock <= end; fromBlock += chunkSize {
+		toBlock := fromBlock + chunkSize - 1
+		if toBlock > end {
+			toBlock = end
+		}
+		opts := bind.FilterOpts{Start: fromBlock, End: &toBlock, Context: ctx}
+
+		rolls, rollsErr := filterer.FilterRoll(&opts, nil)
+		if rollsErr != nil {
+			return history, rollsErr
+		}
+		for rolls.Next() {
+			history.Rolls = append(history.Rolls, Roll{
+				BlockNumber: rolls.Event.Raw.BlockNumber,
+				LogIndex:    uint64(rolls.Event.Raw.Index),
+				Player:      rolls.Event.Player,
+			})
+		}
+		rolls.Close()
+		if rolls.Error() != nil {
+			return history, rolls.Error()
+		}
+
+		awards, awardsErr := filterer.FilterAward(&opts, nil, nil)
+		if awardsErr != nil {
+			return history, awardsErr
+		}
+		for awards.Next() {
+			history.Awards = append(history.Awards, Award{
+				BlockNumber: awards.Event.Raw.BlockNumber,
+				LogIndex:    uint64(awards.Event.Raw.Index),
+				Player:      awards.Event.Player,
+				Outcome:     awards.Event.Outcome.Uint64(),
+				Value:       awards.Event.Value,
+			})
+		}
+		awards.Close()
+		if awards.Error() != nil {
+			return history, awards.Error()
+		}
+	}
+
+	history.sort()
+	return history, nil
+}
@@ -0,0 +1,300 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Number of distinct outcomes of a roll on the JackpotJunction contract.
+const NumOutcomes int = 5
+
+// Outcome index of the jackpot.
+const JackpotOutcome uint64 = 4
+
+// Parameters are the JackpotJunction contract parameters that the analytics depend on.
+type Parameters struct {
+	BlocksToAct  uint64
+	CostToRoll   *big.Int
+	CostToReroll *big.Int
+}
+
+// OutcomeStats summarizes the Award events for a single outcome. For outcome 1 (items), Value counts
+// items instead of wei.
+type OutcomeStats struct {
+	Outcome uint64   `json:"outcome"`
+	Count   uint64   `json:"count"`
+	Value   *big.Int `json:"value"`
+}
+
+// RerollBucket counts the accepted outcomes which were preceded by the given number of rerolls.
+type RerollBucket struct {
+	Outcome uint64 `json:"outcome"`
+	Rerolls uint64 `json:"rerolls"`
+	Count   uint64 `json:"count"`
+}
+
+// PlayerStats summarizes the activity of a single player.
+type PlayerStats struct {
+	Player   common.Address `json:"player"`
+	Rolls    uint64         `json:"rolls"`
+	Rerolls  uint64         `json:"rerolls"`
+	Awards   uint64         `json:"awards"`
+	Jackpots uint64         `json:"jackpots"`
+	Paid     *big.Int       `json:"paid"`
+	Received *big.Int       `json:"received"`
+}
+
+// BalancePoint is the native token balance of the contract at a given block.
+type BalancePoint struct {
+	BlockNumber uint64   `json:"block_number"`
+	Balance     *big.Int `json:"balance"`
+}
+
+// Report is the economy report produced by Analyze. All amounts of native token are in wei.
+type Report struct {
+	FirstBlock uint64 `json:"first_block"`
+	LastBlock  uint64 `json:"last_block"`
+	Rolls      uint64 `json:"rolls"`
+	Rerolls    uint64 `json:"rerolls"`
+	Awards     uint64 `json:"awards"`
+	// FeesCollected assumes that each roll paid exactly CostToRoll and each reroll paid exactly CostToReroll.
+	// Any excess value that players send is not visible in the events.
+	FeesCollected *big.Int       `json:"fees_collected"`
+	PaidOut       *big.Int       `json:"paid_out"`
+	Outcomes      []OutcomeStats `json:"outcomes"`
+	// HouseEdge is (FeesCollected - PaidOut) / FeesCollected.
+	HouseEdge float64 `json:"house_edge"`
+	Jackpots  uint64  `json:"jackpots"`
+	// JackpotFrequency is the number of jackpots per roll (including rerolls).
+	JackpotFrequency   float64        `json:"jackpot_frequency"`
+	RerollDistribution []RerollBucket `json:"reroll_distribution"`
+	Balance            []BalancePoint `json:"balance"`
+	TopPlayers         []PlayerStats  `json:"top_players"`
+}
+
+// Analyze computes the economy report for the given history. It classifies each Roll event as a roll or a
+// reroll the same way the contract does: a roll is a reroll if it happens within BlocksToAct blocks of the
+// player's previous roll and the player has not accepted an outcome since. The report includes the top
+// numTopPlayers players by amount of native token received.
+func Analyze(history History, parameters Parameters, numTopPlayers int) Report {
+	report := Report{
+		FeesCollected:      new(big.Int),
+		PaidOut:            new(big.Int),
+		Outcomes:           make([]OutcomeStats, NumOutcomes),
+		RerollDistribution: []RerollBucket{},
+		Balance:            []BalancePoint{},
+		TopPlayers:         []PlayerStats{},
+	}
+	for i := range report.Outcomes {
+		report.Outcomes[i] = OutcomeStats{Outcome: uint64(i), Value: new(big.Int)}
+	}
+
+	players := make(map[common.Address]*PlayerStats)
+	playerStats := func(player common.Address) *PlayerStats {
+		stats, ok := players[player]
+		if !ok {
+			stats = &PlayerStats{Player: player, Paid: new(big.Int), Received: new(big.Int)}
+			players[player] = stats
+		}
+		return stats
+	}
+
+	lastRollBlock := make(map[common.Address]uint64)
+	rerollsInSequence := make(map[common.Address]uint64)
+	rerollCounts := make(map[[2]uint64]uint64)
+
+	updateBlockRange := func(blockNumber uint64) {
+		if report.FirstBlock == 0 || blockNumber < report.FirstBlock {
+			report.FirstBlock = blockNumber
+		}
+		if blockNumber > report.LastBlock {
+			report.LastBlock = blockNumber
+		}
+	}
+
+	// Merge rolls and awards in the order in which they were emitted.
+	i, j := 0, 0
+	for i < len(history.Rolls) || j < len(history.Awards) {
+		takeRoll := j >= len(history.Awards)
+		if i < len(history.Rolls) && j < len(history.Awards) {
+			roll, award := history.Rolls[i], history.Awards[j]
+			takeRoll = roll.BlockNumber < award.BlockNumber || (roll.BlockNumber == award.BlockNumber && roll.LogIndex < award.LogIndex)
+		}
+
+		if takeRoll {
+			roll := history.Rolls[i]
+			i++
+			updateBlockRange(roll.BlockNumber)
+			stats := playerStats(roll.Player)
+
+			previous := lastRollBlock[roll.Player]
+			if previous != 0 && roll.BlockNumber <= previous+parameters.BlocksToAct {
+				report.Rerolls++
+				stats.Rerolls++
+				rerollsInSequence[roll.Player]++
+				report.FeesCollected.Add(report.FeesCollected, parameters.CostToReroll)
+				stats.Paid.Add(stats.Paid, parameters.CostToReroll)
+			} else {
+				report.Rolls++
+				stats.Rolls++
+				rerollsInSequence[roll.Player] = 0
+				report.FeesCollected.Add(report.FeesCollected, parameters.CostToRoll)
+				stats.Paid.Add(stats.Paid, parameters.CostToRoll)
+			}
+			lastRollBlock[roll.Player] = roll.BlockNumber
+		} else {
+			award := history.Awards[j]
+			j++
+			updateBlockRange(award.BlockNumber)
+			stats := playerStats(award.Player)
+
+			report.Awards++
+			stats.Awards++
+			if award.Outcome < uint64(NumOutcomes) {
+				outcomeStats := &report.Outcomes[award.Outcome]
+				outcomeStats.Count++
+				if award.Outcome == 1 {
+					outcomeStats.Value.Add(outcomeStats.Value, big.NewInt(1))
+				} else {
+					outcomeStats.Value.Add(outcomeStats.Value, award.Value)
+				}
+			}
+			if award.Outcome >= 2 {
+				report.PaidOut.Add(report.PaidOut, award.Value)
+				stats.Received.Add(stats.Received, award.Value)
+			}
+			if award.Outcome == JackpotOutcome {
+				report.Jackpots++
+				stats.Jackpots++
+			}
+
+			rerollCounts[[2]uint64{award.Outcome, rerollsInSequence[award.Player]}]++
+			lastRollBlock[award.Player] = 0
+			rerollsInSequence[award.Player] = 0
+		}
+	}
+
+	if report.FeesCollected.Sign() > 0 {
+		edge := new(big.Rat).SetFrac(new(big.Int).Sub(report.FeesCollected, report.PaidOut), report.FeesCollected)
+		report.HouseEdge, _ = edge.Float64()
+	}
+	if report.Rolls+report.Rerolls > 0 {
+		report.JackpotFrequency = float64(report.Jackpots) / float64(report.Rolls+report.Rerolls)
+	}
+
+	for key, count := range rerollCounts {
+		report.RerollDistribution = append(report.RerollDistribution, RerollBucket{Outcome: key[0], Rerolls: key[1], Count: count})
+	}
+	sort.Slice(report.RerollDistribution, func(i, j int) bool {
+		if report.RerollDistribution[i].Outcome != report.RerollDistribution[j].Outcome {
+			return report.RerollDistribution[i].Outcome < report.RerollDistribution[j].Outcome
+		}
+		return report.RerollDistribution[i].Rerolls < report.RerollDistribution[j].Rerolls
+	})
+
+	allPlayers := make([]PlayerStats, 0, len(players))
+	for _, stats := range players {
+		allPlayers = append(allPlayers, *stats)
+	}
+	sort.Slice(allPlayers, func(i, j int) bool {
+		comparison := allPlayers[i].Received.Cmp(allPlayers[j].Received)
+		if comparison != 0 {
+			return comparison > 0
+		}
+		if allPlayers[i].Rolls+allPlayers[i].Rerolls != allPlayers[j].Rolls+allPlayers[j].Rerolls {
+			return allPlayers[i].Rolls+allPlayers[i].Rerolls > allPlayers[j].Rolls+allPlayers[j].Rerolls
+		}
+		return allPlayers[i].Player.Hex() < allPlayers[j].Player.Hex()
+	})
+	if len(allPlayers) > numTopPlayers {
+		allPlayers = allPlayers[:numTopPlayers]
+	}
+	report.TopPlayers = allPlayers
+
+	return report
+}
+
+// BalanceReader is satisfied by *ethclient.Client.
+type BalanceReader interface {
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// SampleBalances reads the native token balance of the contract at numSamples evenly spaced blocks between
+// the first and last blocks of the report (inclusive). Reading balances at past blocks requires an archive
+// node.
+func SampleBalances(ctx context.Context, client BalanceReader, contractAddress common.Address, report *Report, numSamples uint64) error {
+	if numSamples == 0 || report.LastBlock == 0 {
+		return nil
+	}
+
+	blockNumbers := []uint64{report.LastBlock}
+	if numSamples > 1 && report.LastBlock > report.FirstBlock {
+		blockNumbers = make([]uint64, numSamples)
+		span := report.LastBlock - report.FirstBlock
+		for i := uint64(0); i < numSamples; i++ {
+			blockNumbers[i] = report.FirstBlock + span*i/(numSamples-1)
+		}
+	}
+
+	for _, blockNumber := range blockNumbers {
+		if len(report.Balance) > 0 && report.Balance[len(report.Balance)-1].BlockNumber == blockNumber {
+			continue
+		}
+		balance, balanceErr := client.BalanceAt(ctx, contractAddress, new(big.Int).SetUint64(blockNumber))
+		if balanceErr != nil {
+			return balanceErr
+		}
+		report.Balance = append(report.Balance, BalancePoint{BlockNumber: blockNumber, Balance: balance})
+	}
+
+	return nil
+}
+
+// WriteTables writes the report to w as a series of human readable tables.
+func WriteTables(w io.Writer, report Report) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "Blocks\t%d - %d\n", report.FirstBlock, report.LastBlock)
+	fmt.Fprintf(tw, "Rolls\t%d\n", report.Rolls)
+	fmt.Fprintf(tw, "Rerolls\t%d\n", report.Rerolls)
+	fmt.Fprintf(tw, "Accepted outcomes\t%d\n", report.Awards)
+	fmt.Fprintf(tw, "Fees collected (wei)\t%s\n", report.FeesCollected.String())
+	fmt.Fprintf(tw, "Paid out (wei)\t%s\n", report.PaidOut.String())
+	fmt.Fprintf(tw, "House edge\t%.4f\n", report.HouseEdge)
+	fmt.Fprintf(tw, "Jackpots\t%d\n", report.Jackpots)
+	fmt.Fprintf(tw, "Jackpot frequency (per roll)\t%g\n", report.JackpotFrequency)
+	fmt.Fprintln(tw)
+
+	fmt.Fprintln(tw, "OUTCOME\tCOUNT\tVALUE (items for outcome 1, wei otherwise)")
+	for _, outcome := range report.Outcomes {
+		fmt.Fprintf(tw, "%d\t%d\t%s\n", outcome.Outcome, outcome.Count, outcome.Value.String())
+	}
+	fmt.Fprintln(tw)
+
+	fmt.Fprintln(tw, "OUTCOME\tREROLLS BEFORE ACCEPTING\tCOUNT")
+	for _, bucket := range report.RerollDistribution {
+		fmt.Fprintf(tw, "%d\t%d\t%d\n", bucket.Outcome, bucket.Rerolls, bucket.Count)
+	}
+	fmt.Fprintln(tw)
+
+	if len(report.Balance) > 0 {
+		fmt.Fprintln(tw, "BLOCK\tCONTRACT BALANCE (wei)")
+		for _, point := range report.Balance {
+			fmt.Fprintf(tw, "%d\t%s\n", point.BlockNumber, point.Balance.String())
+		}
+		fmt.Fprintln(tw)
+	}
+
+	fmt.Fprintln(tw, "PLAYER\tROLLS\tREROLLS\tACCEPTED\tJACKPOTS\tPAID (wei)\tRECEIVED (wei)")
+	for _, player := range report.TopPlayers {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%s\t%s\n", player.Player.Hex(), player.Rolls, player.Rerolls, player.Awards, player.Jackpots, player.Paid.String(), player.Received.String())
+	}
+
+	return tw.Flush()
+}
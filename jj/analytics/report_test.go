@@ -0,0 +1,118 @@
+package analytics
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var parameters Parameters = Parameters{BlocksToAct: 10, CostToRoll: big.NewInt(100), CostToReroll: big.NewInt(25)}
+
+var alice, bob common.Address = common.HexToAddress("0xa11ce"), common.HexToAddress("0xb0b")
+
+func TestAnalyzeClassifiesRolls(t *testing.T) {
+	cases := []struct {
+		name    string
+		history History
+		rolls   uint64
+		rerolls uint64
+	}{
+		{
+			"reroll within BlocksToAct",
+			History{Rolls: []Roll{{100, 0, alice}, {110, 0, alice}}},
+			1, 1,
+		},
+		{
+			"roll after BlocksToAct",
+			History{Rolls: []Roll{{100, 0, alice}, {111, 0, alice}}},
+			2, 0,
+		},
+		{
+			"rerolls chain from the latest roll",
+			History{Rolls: []Roll{{100, 0, alice}, {108, 0, alice}, {116, 0, alice}, {127, 0, alice}}},
+			2, 2,
+		},
+		{
+			"accept ends the sequence",
+			History{
+				Rolls:  []Roll{{100, 0, alice}, {103, 0, alice}},
+				Awards: []Award{{102, 0, alice, 0, big.NewInt(0)}},
+			},
+			2, 0,
+		},
+		{
+			"accept in the same block before the roll",
+			History{
+				Rolls:  []Roll{{100, 0, alice}, {102, 1, alice}},
+				Awards: []Award{{102, 0, alice, 0, big.NewInt(0)}},
+			},
+			2, 0,
+		},
+		{
+			"players are tracked separately",
+			History{Rolls: []Roll{{100, 0, alice}, {101, 0, bob}, {102, 0, alice}}},
+			2, 1,
+		},
+	}
+
+	for _, c := range cases {
+		report := Analyze(c.history, parameters, 10)
+		if report.Rolls != c.rolls || report.Rerolls != c.rerolls {
+			t.Errorf("%s: expected %d rolls and %d rerolls, got %d and %d", c.name, c.rolls, c.rerolls, report.Rolls, report.Rerolls)
+		}
+		expectedFees := new(big.Int).Mul(parameters.CostToRoll, new(big.Int).SetUint64(c.rolls))
+		expectedFees.Add(expectedFees, new(big.Int).Mul(parameters.CostToReroll, new(big.Int).SetUint64(c.rerolls)))
+		if report.FeesCollected.Cmp(expectedFees) != 0 {
+			t.Errorf("%s: expected fees of %s, got %s", c.name, expectedFees.String(), report.FeesCollected.String())
+		}
+	}
+}
+
+func TestAnalyzeTotals(t *testing.T) {
+	history := History{
+		Rolls: []Roll{{100, 0, alice}, {101, 0, alice}, {102, 0, alice}, {100, 1, bob}, {200, 0, bob}},
+		Awards: []Award{
+			{103, 0, alice, JackpotOutcome, big.NewInt(400)},
+			{101, 1, bob, 1, big.NewInt(90)},
+			{201, 0, bob, 2, big.NewInt(50)},
+		},
+	}
+	history.sort()
+
+	report := Analyze(history, parameters, 1)
+	if report.FirstBlock != 100 || report.LastBlock != 201 {
+		t.Errorf("expected blocks 100 to 201, got %d to %d", report.FirstBlock, report.LastBlock)
+	}
+	if report.Rolls != 3 || report.Rerolls != 2 || report.Awards != 3 || report.Jackpots != 1 {
+		t.Errorf("unexpected counts: %+v", report)
+	}
+	if report.FeesCollected.Int64() != 350 || report.PaidOut.Int64() != 450 {
+		t.Errorf("expected fees of 350 and payouts of 450, got %s and %s", report.FeesCollected.String(), report.PaidOut.String())
+	}
+	if report.HouseEdge != -100.0/350.0 {
+		t.Errorf("expected a house edge of %f, got %f", -100.0/350.0, report.HouseEdge)
+	}
+	if report.JackpotFrequency != 0.2 {
+		t.Errorf("expected a jackpot frequency of 0.2, got %f", report.JackpotFrequency)
+	}
+	// Items are counted, not valued.
+	if report.Outcomes[1].Count != 1 || report.Outcomes[1].Value.Int64() != 1 {
+		t.Errorf("expected a single item award, got %+v", report.Outcomes[1])
+	}
+
+	expectedBuckets := []RerollBucket{{1, 0, 1}, {2, 0, 1}, {JackpotOutcome, 2, 1}}
+	if len(report.RerollDistribution) != len(expectedBuckets) {
+		t.Fatalf("expected reroll distribution %v, got %v", expectedBuckets, report.RerollDistribution)
+	}
+	for i, bucket := range expectedBuckets {
+		if report.RerollDistribution[i] != bucket {
+			t.Errorf("expected reroll distribution %v, got %v", expectedBuckets, report.RerollDistribution)
+			break
+		}
+	}
+
+	if len(report.TopPlayers) != 1 || report.TopPlayers[0].Player != alice || report.TopPlayers[0].Received.Int64() != 400 {
+		t.Errorf("expected alice to be the top player, got %+v", report.TopPlayers)
+	}
+}
@@ -0,0 +1,91 @@
+package bonus
+
+import (
+	"github.com/moonstream-to/degen-trail/jj/craft"
+	"github.com/moonstream-to/degen-trail/jj/items"
+)
+
+// Reasons that a slot can fail the conditions that the JackpotJunction contract's hasBonus method imposes
+// on the player's equipped items.
+const (
+	ReasonOK              string = "ok"
+	ReasonEmpty           string = "empty"
+	ReasonOutranked       string = "outranked"
+	ReasonTerrainMismatch string = "terrain mismatch"
+)
+
+// SlotExplanation describes the item equipped in a single slot and whether it satisfies the conditions
+// for the bonus.
+type SlotExplanation struct {
+	ItemType    uint64 `json:"item_type"`
+	Empty       bool   `json:"empty"`
+	PoolID      uint64 `json:"pool_id"`
+	TerrainType uint64 `json:"terrain_type"`
+	Tier        uint64 `json:"tier"`
+	// CurrentTier is the largest tier that has been unlocked for the (itemType, terrainType) pair of the
+	// equipped item.
+	CurrentTier uint64 `json:"current_tier"`
+	// Reasons lists every condition that the slot fails. It contains only ReasonOK if the slot satisfies
+	// all of them.
+	Reasons []string `json:"reasons"`
+}
+
+// Explanation is a breakdown of the hasBonus check for a single player, along with the cheapest plan
+// that would restore the bonus.
+type Explanation struct {
+	HasBonus bool `json:"has_bonus"`
+	// TerrainType is the terrain type of the equipped cover, which every other slot has to match. It is
+	// only meaningful if the cover slot is not empty.
+	TerrainType uint64                          `json:"terrain_type"`
+	Slots       [items.NumKinds]SlotExplanation `json:"slots"`
+	Suggestion  craft.Plan                      `json:"suggestion"`
+}
+
+// Explain reproduces the logic of the hasBonus method on the JackpotJunction contract for the given
+// inventory. Unlike hasBonus, it checks every slot instead of stopping at the first failure, and it
+// suggests the plan with the fewest transactions which would give the player the bonus.
+func Explain(inventory craft.Inventory) Explanation {
+	explanation := Explanation{HasBonus: true}
+
+	coverEquipped := inventory.Equipped[0] != nil
+	if coverEquipped {
+		explanation.TerrainType = uint64(items.FromPoolID(inventory.Equipped[0].Uint64()).Terrain)
+	}
+
+	for itemType := uint64(0); itemType < items.NumKinds; itemType++ {
+		slot := SlotExplanation{
+			ItemType: itemType,
+			Reasons:  []string{},
+		}
+
+		equipped := inventory.Equipped[itemType]
+		if equipped == nil {
+			slot.Empty = true
+			slot.Reasons = append(slot.Reasons, ReasonEmpty)
+		} else {
+			slot.PoolID = equipped.Uint64()
+			item := items.FromPoolID(slot.PoolID)
+			slot.TerrainType, slot.Tier = uint64(item.Terrain), item.Tier
+			slot.CurrentTier = inventory.CurrentTier[itemType][slot.TerrainType]
+
+			if slot.Tier != slot.CurrentTier {
+				slot.Reasons = append(slot.Reasons, ReasonOutranked)
+			}
+			if coverEquipped && slot.TerrainType != explanation.TerrainType {
+				slot.Reasons = append(slot.Reasons, ReasonTerrainMismatch)
+			}
+		}
+
+		if len(slot.Reasons) == 0 {
+			slot.Reasons = append(slot.Reasons, ReasonOK)
+		} else {
+			explanation.HasBonus = false
+		}
+
+		explanation.Slots[itemType] = slot
+	}
+
+	explanation.Suggestion = craft.BestPlan(inventory)
+
+	return explanation
+}
@@ -0,0 +1,212 @@
+package bonus
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction"
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction/jjtest"
+	"github.com/moonstream-to/degen-trail/jj/craft"
+	"github.com/moonstream-to/degen-trail/jj/items"
+)
+
+var forest uint64 = uint64(items.Forest)
+var plains uint64 = uint64(items.Plains)
+
+// Returns an inventory with the given tier equipped in every slot, for the given terrain types (one per slot,
+// with items.NumTerrains standing for an empty slot).
+func equippedInventory(tier uint64, terrainTypes [items.NumKinds]uint64) craft.Inventory {
+	inventory := craft.Inventory{Balances: map[uint64]*big.Int{}}
+	for itemType, terrainType := range terrainTypes {
+		if terrainType < items.NumTerrains {
+			inventory.Equipped[itemType] = new(big.Int).SetUint64(craft.PoolID(uint64(itemType), terrainType, tier))
+		}
+	}
+	return inventory
+}
+
+func TestExplain(t *testing.T) {
+	empty := items.NumTerrains
+
+	cases := []struct {
+		name      string
+		inventory craft.Inventory
+		hasBonus  bool
+		reasons   [items.NumKinds][]string
+	}{
+		{
+			"eligible",
+			equippedInventory(0, [items.NumKinds]uint64{forest, forest, forest, forest}),
+			true,
+			[items.NumKinds][]string{{ReasonOK}, {ReasonOK}, {ReasonOK}, {ReasonOK}},
+		},
+		{
+			"nothing equipped",
+			equippedInventory(0, [items.NumKinds]uint64{empty, empty, empty, empty}),
+			false,
+			[items.NumKinds][]string{{ReasonEmpty}, {ReasonEmpty}, {ReasonEmpty}, {ReasonEmpty}},
+		},
+		{
+			"empty slot",
+			equippedInventory(0, [items.NumKinds]uint64{forest, forest, empty, forest}),
+			false,
+			[items.NumKinds][]string{{ReasonOK}, {ReasonOK}, {ReasonEmpty}, {ReasonOK}},
+		},
+		{
+			// Without a cover, there is no terrain type for the other slots to match.
+			"empty cover",
+			equippedInventory(0, [items.NumKinds]uint64{empty, forest, plains, forest}),
+			false,
+			[items.NumKinds][]string{{ReasonEmpty}, {ReasonOK}, {ReasonOK}, {ReasonOK}},
+		},
+		{
+			"outranked by CurrentTier",
+			func() craft.Inventory {
+				inventory := equippedInventory(0, [items.NumKinds]uint64{forest, forest, forest, forest})
+				inventory.CurrentTier[items.Wheels][forest] = 1
+				return inventory
+			}(),
+			false,
+			[items.NumKinds][]string{{ReasonOK}, {ReasonOK}, {ReasonOutranked}, {ReasonOK}},
+		},
+		{
+			"higher tier than CurrentTier",
+			func() craft.Inventory {
+				inventory := equippedInventory(0, [items.NumKinds]uint64{forest, forest, forest, forest})
+				inventory.Equipped[items.Body] = new(big.Int).SetUint64(craft.PoolID(uint64(items.Body), forest, 1))
+				return inventory
+			}(),
+			false,
+			[items.NumKinds][]string{{ReasonOK}, {ReasonOutranked}, {ReasonOK}, {ReasonOK}},
+		},
+		{
+			"terrain mismatch",
+			equippedInventory(0, [items.NumKinds]uint64{forest, plains, forest, forest}),
+			false,
+			[items.NumKinds][]string{{ReasonOK}, {ReasonTerrainMismatch}, {ReasonOK}, {ReasonOK}},
+		},
+		{
+			"outranked and terrain mismatch",
+			func() craft.Inventory {
+				inventory := equippedInventory(0, [items.NumKinds]uint64{forest, forest, forest, plains})
+				inventory.CurrentTier[items.Beasts][plains] = 2
+				return inventory
+			}(),
+			false,
+			[items.NumKinds][]string{{ReasonOK}, {ReasonOK}, {ReasonOK}, {ReasonOutranked, ReasonTerrainMismatch}},
+		},
+	}
+
+	for _, c := range cases {
+		explanation := Explain(c.inventory)
+		if explanation.HasBonus != c.hasBonus {
+			t.Errorf("%s: expected HasBonus %t, got %t", c.name, c.hasBonus, explanation.HasBonus)
+		}
+		if c.inventory.Equipped[items.Cover] != nil && explanation.TerrainType != uint64(items.FromPoolID(c.inventory.Equipped[items.Cover].Uint64()).Terrain) {
+			t.Errorf("%s: expected the terrain type of the cover, got %d", c.name, explanation.TerrainType)
+		}
+		for itemType, slot := range explanation.Slots {
+			if slot.ItemType != uint64(itemType) || slot.Empty != (c.inventory.Equipped[itemType] == nil) {
+				t.Errorf("%s: unexpected slot %+v", c.name, slot)
+			}
+			if !reflect.DeepEqual(slot.Reasons, c.reasons[itemType]) {
+				t.Errorf("%s: expected reasons %v for %s, got %v", c.name, c.reasons[itemType], items.Kind(itemType), slot.Reasons)
+			}
+		}
+		if !reflect.DeepEqual(explanation.Suggestion, craft.BestPlan(c.inventory)) {
+			t.Errorf("%s: expected the suggestion to be the best plan, got %+v", c.name, explanation.Suggestion)
+		}
+	}
+}
+
+func TestExplainSuggestion(t *testing.T) {
+	// The wheels slot is empty, and the wallet holds two tier 0 forest wheels while tier 1 is current.
+	inventory := equippedInventory(1, [items.NumKinds]uint64{forest, forest, items.NumTerrains, forest})
+	for itemType := range inventory.CurrentTier {
+		inventory.CurrentTier[itemType][forest] = 1
+	}
+	wheels := craft.PoolID(uint64(items.Wheels), forest, 0)
+	inventory.Balances[wheels] = big.NewInt(2)
+
+	suggestion := Explain(inventory).Suggestion
+	if !suggestion.Feasible || suggestion.TerrainType != forest {
+		t.Fatalf("expected a feasible plan for forest, got %+v", suggestion)
+	}
+	if len(suggestion.Crafts) != 1 || suggestion.Crafts[0].PoolID != wheels || suggestion.Crafts[0].NumOutputs.Int64() != 1 {
+		t.Errorf("expected to craft a single tier 1 forest wheels, got %v", suggestion.Crafts)
+	}
+	if len(suggestion.Equip) != 1 || suggestion.Equip[0] != craft.PoolID(uint64(items.Wheels), forest, 1) {
+		t.Errorf("expected to equip the crafted wheels, got %v", suggestion.Equip)
+	}
+}
+
+// Rolls until the player wins an item from the given pool.
+func rollForPool(t *testing.T, h *jjtest.Harness, player *jjtest.Account, poolID uint64) *big.Int {
+	t.Helper()
+	award, awardErr := h.RollUntil(player, func(outcome JackpotJunction.OutcomeResult) bool {
+		return outcome.Outcome.Int64() == 1 && outcome.Reward.Uint64() == poolID
+	}, 5000)
+	if awardErr != nil {
+		t.Fatalf("could not win %s: %s", items.FromPoolID(poolID).String(), awardErr.Error())
+	}
+	return award.Value
+}
+
+// Equips, crafts and unlocks items on the simulated chain, and checks after every step that Explain agrees with
+// hasBonus on the contract.
+func TestExplainMatchesHasBonus(t *testing.T) {
+	h := jjtest.New(t, jjtest.Options{NumPlayers: 1})
+	player := h.Players[0]
+
+	check := func(step string, expected bool) {
+		t.Helper()
+		hasBonus, hasBonusErr := h.Contract.HasBonus(nil, player.Address)
+		if hasBonusErr != nil {
+			t.Fatalf("%s: hasBonus failed: %s", step, hasBonusErr.Error())
+		}
+		inventory, inventoryErr := craft.ReadInventory(&h.Contract.JackpotJunctionCaller, nil, player.Address)
+		if inventoryErr != nil {
+			t.Fatalf("%s: could not read inventory: %s", step, inventoryErr.Error())
+		}
+		explanation := Explain(inventory)
+		if explanation.HasBonus != hasBonus {
+			t.Errorf("%s: hasBonus returned %t, Explain returned %t (%+v)", step, hasBonus, explanation.HasBonus, explanation.Slots)
+		}
+		if hasBonus != expected {
+			t.Errorf("%s: expected hasBonus to return %t", step, expected)
+		}
+	}
+	equip := func(step string, expected bool, poolIDs ...*big.Int) {
+		t.Helper()
+		if _, equipErr := h.Equip(player, poolIDs...); equipErr != nil {
+			t.Fatalf("%s: could not equip: %s", step, equipErr.Error())
+		}
+		check(step, expected)
+	}
+
+	check("nothing equipped", false)
+
+	cover := rollForPool(t, h, player, craft.PoolID(uint64(items.Cover), forest, 0))
+	equip("equip a cover", false, cover)
+
+	set := []*big.Int{}
+	for _, kind := range []items.Kind{items.Body, items.Wheels, items.Beasts} {
+		set = append(set, rollForPool(t, h, player, craft.PoolID(uint64(kind), forest, 0)))
+	}
+	equip("equip a full set", true, set...)
+
+	// Crafting two more covers unlocks tier 1, which outranks the equipped cover.
+	rollForPool(t, h, player, cover.Uint64())
+	rollForPool(t, h, player, cover.Uint64())
+	if _, craftErr := h.Craft(player, cover, 1); craftErr != nil {
+		t.Fatalf("could not craft: %s", craftErr.Error())
+	}
+	check("craft a tier 1 cover", false)
+
+	// The equipped body, wheels and beasts are still at the current tier, since only covers were crafted.
+	equip("equip the crafted cover", true, new(big.Int).SetUint64(craft.PoolID(uint64(items.Cover), forest, 1)))
+
+	body := rollForPool(t, h, player, craft.PoolID(uint64(items.Body), plains, 0))
+	equip("equip a body from another terrain", false, body)
+}
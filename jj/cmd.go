@@ -1,33 +1,75 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"math/big"
 	"net/http"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/spf13/cobra"
 
 	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction"
+	"github.com/moonstream-to/degen-trail/jj/analytics"
+	"github.com/moonstream-to/degen-trail/jj/bonus"
+	"github.com/moonstream-to/degen-trail/jj/config"
+	"github.com/moonstream-to/degen-trail/jj/craft"
+	"github.com/moonstream-to/degen-trail/jj/economy"
 	"github.com/moonstream-to/degen-trail/jj/entropy"
+	"github.com/moonstream-to/degen-trail/jj/index"
+	"github.com/moonstream-to/degen-trail/jj/items"
+	"github.com/moonstream-to/degen-trail/jj/leaderboard"
+	"github.com/moonstream-to/degen-trail/jj/notify"
 	"github.com/moonstream-to/degen-trail/jj/version"
+	"github.com/moonstream-to/degen-trail/jj/watch"
 )
 
 func CreateRootCommand() *cobra.Command {
 	// rootCmd represents the base command when called without any subcommands
+	var profileName string
+
 	rootCmd := &cobra.Command{
 		Use:   "jj",
 		Short: "jj: The Jackpot Junction CLI",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return ApplyProfile(cmd, profileName)
+		},
 		Run: func(cmd *cobra.Command, args []string) {
 			cmd.Help()
 		},
 	}
 
+	rootCmd.PersistentFlags().StringVar(&profileName, "profile", "", "Name of the profile (from the jj configuration file) to take default flag values from (defaults to the JJ_PROFILE environment variable, then the default profile)")
+
 	completionCmd := CreateCompletionCommand(rootCmd)
 	versionCmd := CreateVersionCommand()
 	entropyCmd := CreateEntropycommand()
+	craftCmd := CreateCraftCommand()
+	bonusCmd := CreateBonusCommand()
+	indexCmd := CreateIndexCommand()
+	analyticsCmd := CreateAnalyticsCommand()
+	leaderboardCmd := CreateLeaderboardCommand()
+	watchCmd := CreateWatchCommand()
+	notifyCmd := CreateNotifyCommand()
+	simulateCmd := CreateSimulateCommand()
+	evCmd := CreateEVCommand()
+	configCmd := CreateConfigCommand()
 	contractCmd := JackpotJunction.CreateJackpotJunctionCommand()
 	contractCmd.Use = "contract"
-	rootCmd.AddCommand(completionCmd, versionCmd, entropyCmd, contractCmd)
+	DecorateContractCommand(contractCmd)
+	rootCmd.AddCommand(completionCmd, versionCmd, entropyCmd, craftCmd, bonusCmd, indexCmd, analyticsCmd, leaderboardCmd, watchCmd, notifyCmd, simulateCmd, evCmd, configCmd, contractCmd)
+	DecodeContractErrors(rootCmd)
 
 	// By default, cobra Command objects write to stderr. We have to forcibly set them to output to
 	// stdout.
@@ -142,3 +184,1841 @@ func CreateEntropycommand() *cobra.Command {
 
 	return entropyCmd
 }
+
+func CreateCraftCommand() *cobra.Command {
+	craftCmd := &cobra.Command{
+		Use:   "craft",
+		Short: "Plan and execute crafting on a JackpotJunction contract",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	planCmd := CreateCraftPlanCommand()
+	craftCmd.AddCommand(planCmd)
+
+	return craftCmd
+}
+
+func CreateCraftPlanCommand() *cobra.Command {
+	var rpc, contractAddressRaw, playerRaw, keyfile, password, signerSpec string
+	var contractAddress, player common.Address
+	var execute bool
+	var timeout uint
+
+	planCmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Compute the crafts required to reach a bonus eligible loadout (and optionally execute them)",
+		Long: `Compute the crafts required to reach a bonus eligible loadout (and optionally execute them).
+
+A player is rolling from the improved distribution when they have items of the current top tier of a single
+terrain type equipped in all four slots. This command reads the player's inventory and the current tiers
+from the JackpotJunction contract and computes the sequence of craft and equip transactions which gets the
+player to such a loadout. If no terrain type is within reach, it reports how many tier 0 items the player
+is missing.
+
+Items that the player has equipped are only crafted if the loadout cannot be reached without them. In that
+case the plan starts by unequipping all items.
+
+If --execute is set, the plan is executed using the account in --keyfile (or --signer). Each transaction
+is mined before the next one is submitted. The contract does not allow equipping or unequipping while the
+player has a roll in progress, so the plan is not executed in that case.`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if contractAddressRaw == "" {
+				return errors.New("--contract is required")
+			} else if !common.IsHexAddress(contractAddressRaw) {
+				return errors.New("--contract is not a valid Ethereum address")
+			}
+			contractAddress = common.HexToAddress(contractAddressRaw)
+
+			if cmd.Flags().Changed("keyfile") && cmd.Flags().Changed("signer") {
+				return errors.New("only one of --keyfile and --signer may be specified")
+			} else if cmd.Flags().Changed("keyfile") {
+				signerSpec = ""
+			} else if signerSpec != "" {
+				keyfile = ""
+			}
+
+			if execute && keyfile == "" && signerSpec == "" {
+				return errors.New("--keyfile or --signer is required when --execute is set")
+			}
+
+			if playerRaw == "" && keyfile == "" && signerSpec == "" {
+				return errors.New("one of --player, --keyfile or --signer is required")
+			} else if playerRaw != "" {
+				if !common.IsHexAddress(playerRaw) {
+					return errors.New("--player is not a valid Ethereum address")
+				}
+				player = common.HexToAddress(playerRaw)
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, clientErr := JackpotJunction.NewClient(rpc)
+			if clientErr != nil {
+				return clientErr
+			}
+
+			var transactionOpts *bind.TransactOpts
+			if keyfile != "" || signerSpec != "" {
+				if signerSpec == "" {
+					signerSpec = "keystore:" + keyfile
+				}
+				signer, signerErr := JackpotJunction.ParseSigner(cmd.Context(), signerSpec, password)
+				if signerErr != nil {
+					return signerErr
+				}
+				if closer, ok := signer.(interface{ Close() }); ok {
+					defer closer.Close()
+				}
+
+				if playerRaw == "" {
+					player = signer.Address()
+				} else if execute && player != signer.Address() {
+					return errors.New("--player does not match the account of the signer")
+				}
+
+				if execute {
+					chainIDCtx, cancelChainIDCtx := JackpotJunction.NewChainContext(timeout)
+					defer cancelChainIDCtx()
+					chainID, chainIDErr := client.ChainID(chainIDCtx)
+					if chainIDErr != nil {
+						return chainIDErr
+					}
+
+					transactionOpts = JackpotJunction.NewSignerTransactOpts(cmd.Context(), signer, chainID)
+				}
+			}
+
+			contract, contractErr := JackpotJunction.NewJackpotJunction(contractAddress, client)
+			if contractErr != nil {
+				return contractErr
+			}
+
+			inventoryCtx, cancelInventoryCtx := JackpotJunction.NewChainContext(timeout)
+			defer cancelInventoryCtx()
+			inventory, inventoryErr := craft.ReadInventory(&contract.JackpotJunctionCaller, &bind.CallOpts{Context: inventoryCtx}, player)
+			if inventoryErr != nil {
+				return inventoryErr
+			}
+
+			plan := craft.BestPlan(inventory)
+
+			cmd.Printf("Terrain type: %s\n", items.Terrain(plan.TerrainType))
+			for _, slot := range plan.Slots {
+				target := items.FromPoolID(slot.TargetPoolID)
+				if slot.Equipped {
+					cmd.Printf("%s: %s is already equipped\n", target.Kind, target)
+				} else if slot.Missing.Sign() > 0 {
+					cmd.Printf("%s: missing %s tier 0 items to reach %s\n", target.Kind, slot.Missing.String(), target)
+				} else {
+					cmd.Printf("%s: equip %s after %d crafts\n", target.Kind, target, len(slot.Crafts))
+				}
+			}
+
+			if !plan.Feasible {
+				cmd.Printf("No bonus eligible loadout is within reach. Closest terrain type is %s, which is missing %s tier 0 items.\n", items.Terrain(plan.TerrainType), plan.Missing.String())
+				return nil
+			}
+
+			if plan.Transactions() == 0 {
+				cmd.Println("Player already has a bonus eligible loadout")
+				return nil
+			}
+
+			cmd.Println("Plan:")
+			step := 0
+			if plan.Unequip {
+				step++
+				cmd.Printf("%d. unequip all items, so that they can be crafted\n", step)
+			}
+			for _, craftStep := range plan.Crafts {
+				step++
+				cmd.Printf("%d. craft %s items from %s\n", step, craftStep.NumOutputs.String(), items.FromPoolID(craftStep.PoolID))
+			}
+			if len(plan.Equip) > 0 {
+				equipNames := make([]string, len(plan.Equip))
+				for i, poolID := range plan.Equip {
+					equipNames[i] = items.FromPoolID(poolID).String()
+				}
+				cmd.Printf("%d. equip %s\n", step+1, strings.Join(equipNames, ", "))
+			}
+
+			if !execute {
+				return nil
+			}
+
+			// Crafting is allowed during a roll, but equipping is not. Check before crafting, so that the plan is
+			// not left half done.
+			headCtx, cancelHeadCtx := JackpotJunction.NewChainContext(timeout)
+			defer cancelHeadCtx()
+			head, headErr := client.BlockNumber(headCtx)
+			if headErr != nil {
+				return headErr
+			}
+			canEquipErr := craft.CheckCanEquip(&contract.JackpotJunctionCaller, &bind.CallOpts{Context: headCtx}, player, head+1)
+			if canEquipErr != nil {
+				return canEquipErr
+			}
+
+			session := JackpotJunction.JackpotJunctionTransactorSession{
+				Contract:     &contract.JackpotJunctionTransactor,
+				TransactOpts: *transactionOpts,
+			}
+
+			if plan.Unequip {
+				transaction, transactionErr := session.Unequip()
+				if transactionErr != nil {
+					return transactionErr
+				}
+				cmd.Printf("Unequip transaction: %s\n", transaction.Hash().Hex())
+
+				if minedErr := waitForSuccess(client, transaction, timeout); minedErr != nil {
+					return minedErr
+				}
+			}
+
+			for _, step := range plan.Crafts {
+				transaction, transactionErr := session.Craft(new(big.Int).SetUint64(step.PoolID), step.NumOutputs)
+				if transactionErr != nil {
+					return transactionErr
+				}
+				cmd.Printf("Craft transaction: %s\n", transaction.Hash().Hex())
+
+				if minedErr := waitForSuccess(client, transaction, timeout); minedErr != nil {
+					return minedErr
+				}
+			}
+
+			if len(plan.Equip) > 0 {
+				poolIDs := make([]*big.Int, len(plan.Equip))
+				for i, poolID := range plan.Equip {
+					poolIDs[i] = new(big.Int).SetUint64(poolID)
+				}
+
+				transaction, transactionErr := session.Equip(poolIDs)
+				if transactionErr != nil {
+					return transactionErr
+				}
+				cmd.Printf("Equip transaction: %s\n", transaction.Hash().Hex())
+
+				if minedErr := waitForSuccess(client, transaction, timeout); minedErr != nil {
+					return minedErr
+				}
+			}
+
+			return nil
+		},
+	}
+
+	planCmd.Flags().StringVar(&rpc, "rpc", "", "URL of the JSONRPC API to use")
+	planCmd.Flags().StringVar(&contractAddressRaw, "contract", "", "Address of the JackpotJunction contract")
+	planCmd.Flags().StringVarP(&playerRaw, "player", "p", "", "Address of the player to plan for (defaults to the account in --keyfile)")
+	planCmd.Flags().BoolVar(&execute, "execute", false, "Set this flag to submit the craft and equip transactions in the plan")
+	planCmd.Flags().StringVar(&keyfile, "keyfile", "", "Path to the keystore file to use for the transactions")
+	planCmd.Flags().StringVar(&password, "password", "", "Password to use to unlock the keystore (if not specified, you will be prompted for the password when the command executes)")
+	planCmd.Flags().StringVar(&signerSpec, "signer", "", signerFlagUsage)
+	planCmd.Flags().UintVar(&timeout, "timeout", 60, "Timeout (in seconds) for interactions with the JSONRPC API")
+
+	return planCmd
+}
+
+func CreateBonusCommand() *cobra.Command {
+	bonusCmd := &cobra.Command{
+		Use:   "bonus",
+		Short: "Inspect whether players are rolling from the improved distribution",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	explainCmd := CreateBonusExplainCommand()
+	bonusCmd.AddCommand(explainCmd)
+
+	return bonusCmd
+}
+
+func CreateBonusExplainCommand() *cobra.Command {
+	var rpc, contractAddressRaw, playerRaw, blockNumberRaw string
+	var contractAddress, player common.Address
+	var timeout uint
+
+	explainCmd := &cobra.Command{
+		Use:   "explain",
+		Short: "Explain why a player does or does not have the bonus applied to their rolls",
+		Long: `Explain why a player does or does not have the bonus applied to their rolls.
+
+This command reproduces the hasBonus check on the JackpotJunction contract. For each slot, it shows the
+equipped item, the current top tier for that item's (item type, terrain type) pair, and which of the bonus
+conditions the slot fails. It also suggests the change with the fewest transactions that would restore
+the bonus.`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if contractAddressRaw == "" {
+				return errors.New("--contract is required")
+			} else if !common.IsHexAddress(contractAddressRaw) {
+				return errors.New("--contract is not a valid Ethereum address")
+			}
+			contractAddress = common.HexToAddress(contractAddressRaw)
+
+			if playerRaw == "" {
+				return errors.New("--player/-p is required")
+			} else if !common.IsHexAddress(playerRaw) {
+				return errors.New("--player is not a valid Ethereum address")
+			}
+			player = common.HexToAddress(playerRaw)
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, clientErr := JackpotJunction.NewClient(rpc)
+			if clientErr != nil {
+				return clientErr
+			}
+
+			contract, contractErr := JackpotJunction.NewJackpotJunction(contractAddress, client)
+			if contractErr != nil {
+				return contractErr
+			}
+
+			ctx, cancel := JackpotJunction.NewChainContext(timeout)
+			defer cancel()
+			callOpts := bind.CallOpts{Context: ctx}
+			JackpotJunction.SetCallParametersFromArgs(&callOpts, false, "", blockNumberRaw)
+
+			inventory, inventoryErr := craft.ReadInventory(&contract.JackpotJunctionCaller, &callOpts, player)
+			if inventoryErr != nil {
+				return inventoryErr
+			}
+
+			hasBonus, hasBonusErr := contract.HasBonus(&callOpts, player)
+			if hasBonusErr != nil {
+				return hasBonusErr
+			}
+
+			explanation := bonus.Explain(inventory)
+			if explanation.HasBonus != hasBonus {
+				cmd.Printf("WARNING: hasBonus on the contract returned %t but the explanation computed %t\n", hasBonus, explanation.HasBonus)
+			}
+
+			cmd.Printf("Bonus: %t\n", hasBonus)
+			for _, slot := range explanation.Slots {
+				kind := items.Kind(slot.ItemType)
+				if slot.Empty {
+					cmd.Printf("%s: empty (%s)\n", kind, strings.Join(slot.Reasons, ", "))
+				} else {
+					cmd.Printf("%s: %s, current tier %d (%s)\n", kind, items.FromPoolID(slot.PoolID), slot.CurrentTier, strings.Join(slot.Reasons, ", "))
+				}
+			}
+
+			if hasBonus {
+				return nil
+			}
+
+			suggestion := explanation.Suggestion
+			if !suggestion.Feasible {
+				cmd.Printf("Suggestion: collect %s more tier 0 %s items\n", suggestion.Missing.String(), items.Terrain(suggestion.TerrainType))
+				return nil
+			}
+
+			equipNames := make([]string, len(suggestion.Equip))
+			for i, poolID := range suggestion.Equip {
+				equipNames[i] = items.FromPoolID(poolID).String()
+			}
+			cmd.Printf("Suggestion: equip %s", strings.Join(equipNames, ", "))
+			if suggestion.Unequip {
+				cmd.Printf(" after unequipping and %d crafts (see: jj craft plan)", len(suggestion.Crafts))
+			} else if len(suggestion.Crafts) > 0 {
+				cmd.Printf(" after %d crafts (see: jj craft plan)", len(suggestion.Crafts))
+			}
+			cmd.Println()
+
+			return nil
+		},
+	}
+
+	explainCmd.Flags().StringVar(&rpc, "rpc", "", "URL of the JSONRPC API to use")
+	explainCmd.Flags().StringVar(&contractAddressRaw, "contract", "", "Address of the JackpotJunction contract")
+	explainCmd.Flags().StringVarP(&playerRaw, "player", "p", "", "Address of the player")
+	explainCmd.Flags().StringVar(&blockNumberRaw, "block", "", "Block number at which to explain the bonus")
+	explainCmd.Flags().UintVar(&timeout, "timeout", 60, "Timeout (in seconds) for interactions with the JSONRPC API")
+
+	return explainCmd
+}
+
+func CreateIndexCommand() *cobra.Command {
+	indexCmd := &cobra.Command{
+		Use:   "index",
+		Short: "Index JackpotJunction events into a local SQLite database",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	crawlCmd := CreateIndexCrawlCommand()
+	statusCmd := CreateIndexStatusCommand()
+	indexCmd.AddCommand(crawlCmd, statusCmd)
+
+	return indexCmd
+}
+
+func CreateIndexCrawlCommand() *cobra.Command {
+	var rpc, contractAddressRaw, dbPath string
+	var contractAddress common.Address
+	var start, end, chunkSize, reorgDepth, confirmations uint64
+	var follow bool
+	var interval, timeout uint
+
+	crawlCmd := &cobra.Command{
+		Use:   "crawl",
+		Short: "Crawl JackpotJunction events from the blockchain into the index",
+		Long: `Crawl JackpotJunction events from the blockchain into the index.
+
+The crawler requests logs in chunks of --chunk-size blocks and stores the decoded Roll, Award, TierUnlocked,
+TransferSingle, TransferBatch, ApprovalForAll, and URI events. If the database already contains indexed
+events, crawling resumes from the block after the last indexed block.
+
+Before each chunk, the crawler checks that the last indexed block is still part of the canonical chain. If it
+is not, it walks back to the last block it indexed that is still part of the canonical chain, deletes the
+events after that block (and at least the last --reorg-depth blocks of events) from the index, and crawls
+them again.
+
+Crafts do not emit a dedicated event. The index reconstructs them from ERC1155 burns and mints in the crafts view.`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if contractAddressRaw == "" {
+				return errors.New("--contract is required")
+			} else if !common.IsHexAddress(contractAddressRaw) {
+				return errors.New("--contract is not a valid Ethereum address")
+			}
+			contractAddress = common.HexToAddress(contractAddressRaw)
+
+			if dbPath == "" {
+				return errors.New("--db is required")
+			}
+
+			if end != 0 && end < start {
+				return errors.New("--end must not be smaller than --start")
+			}
+
+			if reorgDepth == 0 {
+				return errors.New("--reorg-depth must be at least 1")
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, clientErr := JackpotJunction.NewClient(rpc)
+			if clientErr != nil {
+				return clientErr
+			}
+
+			db, dbErr := index.Open(dbPath)
+			if dbErr != nil {
+				return dbErr
+			}
+			defer db.Close()
+
+			indexer := index.Indexer{
+				DB:            db,
+				Backend:       client,
+				Contract:      contractAddress,
+				ChunkSize:     chunkSize,
+				ReorgDepth:    reorgDepth,
+				Confirmations: confirmations,
+				Timeout:       timeout,
+				Progress: func(fromBlock, toBlock uint64, numEvents int) {
+					cmd.Printf("Indexed blocks %d-%d: %d events\n", fromBlock, toBlock, numEvents)
+				},
+				Reorg: func(lastValidBlock uint64) {
+					cmd.Printf("Reorg detected -- rolled back to block %d\n", lastValidBlock)
+				},
+			}
+
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer cancel()
+
+			if follow {
+				followErr := indexer.Follow(ctx, start, time.Duration(interval)*time.Second)
+				if errors.Is(followErr, context.Canceled) {
+					return nil
+				}
+				return followErr
+			}
+
+			lastBlock, runErr := indexer.Run(ctx, start, end)
+			if runErr != nil {
+				return runErr
+			}
+			cmd.Printf("Last indexed block: %d\n", lastBlock)
+
+			return nil
+		},
+	}
+
+	crawlCmd.Flags().StringVar(&rpc, "rpc", "", "URL of the JSONRPC API to use")
+	crawlCmd.Flags().StringVar(&contractAddressRaw, "contract", "", "Address of the JackpotJunction contract")
+	crawlCmd.Flags().StringVar(&dbPath, "db", "jj.db", "Path to the SQLite database to store the index in")
+	crawlCmd.Flags().Uint64Var(&start, "start", 0, "Block to start crawling from (usually the block in which the contract was deployed)")
+	crawlCmd.Flags().Uint64Var(&end, "end", 0, "Last block to crawl (if 0, crawls up to the current block)")
+	crawlCmd.Flags().Uint64Var(&chunkSize, "chunk-size", 1000, "Number of blocks to request logs for at a time")
+	crawlCmd.Flags().Uint64Var(&reorgDepth, "reorg-depth", 64, "Minimum number of blocks to roll back when a reorg is detected")
+	crawlCmd.Flags().Uint64Var(&confirmations, "confirmations", 0, "Number of blocks behind the head of the chain to stay")
+	crawlCmd.Flags().BoolVar(&follow, "follow", false, "Keep crawling new blocks as they are produced")
+	crawlCmd.Flags().UintVar(&interval, "interval", 10, "Time (in seconds) to wait between crawls when --follow is set")
+	crawlCmd.Flags().UintVar(&timeout, "timeout", 60, "Timeout (in seconds) for interactions with the JSONRPC API")
+
+	return crawlCmd
+}
+
+func CreateIndexStatusCommand() *cobra.Command {
+	var dbPath string
+
+	statusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the last indexed block and the number of indexed events of each type",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, dbErr := index.Open(dbPath)
+			if dbErr != nil {
+				return dbErr
+			}
+			defer db.Close()
+
+			lastBlock, indexed, lastBlockErr := index.LastIndexedBlock(db)
+			if lastBlockErr != nil {
+				return lastBlockErr
+			}
+			if !indexed {
+				cmd.Println("Nothing has been indexed yet")
+				return nil
+			}
+			cmd.Printf("Last indexed block: %d\n", lastBlock)
+
+			for _, table := range []string{"rolls", "awards", "tier_unlocks", "transfers", "crafts", "approvals", "uris"} {
+				var count int64
+				countErr := db.QueryRow("SELECT COUNT(*) FROM " + table).Scan(&count)
+				if countErr != nil {
+					return countErr
+				}
+				cmd.Printf("%s: %d\n", table, count)
+			}
+
+			return nil
+		},
+	}
+
+	statusCmd.Flags().StringVar(&dbPath, "db", "jj.db", "Path to the SQLite database that stores the index")
+
+	return statusCmd
+}
+
+func CreateAnalyticsCommand() *cobra.Command {
+	var rpc, contractAddressRaw, dbPath, format, costToRollRaw, costToRerollRaw string
+	var contractAddress common.Address
+	var start, end, chunkSize, blocksToAct, balanceSamples uint64
+	var top int
+	var timeout uint
+
+	analyticsCmd := &cobra.Command{
+		Use:   "analytics",
+		Short: "Report on the JackpotJunction game economy from its Roll and Award history",
+		Long: `Report on the JackpotJunction game economy from its Roll and Award history.
+
+The history is read from an index created with "jj index crawl" if --db is set, and otherwise directly from
+the logs of the contract via the JSONRPC API.
+
+The report contains the total numbers of rolls and rerolls, the fees collected, the amount paid out for each
+outcome, the realized house edge, the jackpot frequency, the distribution of the number of rerolls before
+each accepted outcome, and the top players. If --balance-samples is set, it also shows the balance of the
+contract over time. Sampling past balances requires an archive node.
+
+The events do not record how much each player paid, so fees are computed from CostToRoll and CostToReroll.
+By default, these (and BlocksToAct) are read from the contract. Set --cost-to-roll, --cost-to-reroll, and
+--blocks-to-act to analyze history under different parameters.`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if contractAddressRaw == "" {
+				return errors.New("--contract is required")
+			} else if !common.IsHexAddress(contractAddressRaw) {
+				return errors.New("--contract is not a valid Ethereum address")
+			}
+			contractAddress = common.HexToAddress(contractAddressRaw)
+
+			if format != "table" && format != "json" {
+				return errors.New("--format must be one of: table, json")
+			}
+
+			if end != 0 && end < start {
+				return errors.New("--end must not be smaller than --start")
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parameters := analytics.Parameters{BlocksToAct: blocksToAct}
+			if costToRollRaw != "" {
+				parameters.CostToRoll = new(big.Int)
+				if _, ok := parameters.CostToRoll.SetString(costToRollRaw, 0); !ok {
+					return errors.New("--cost-to-roll is not a valid integer")
+				}
+			}
+			if costToRerollRaw != "" {
+				parameters.CostToReroll = new(big.Int)
+				if _, ok := parameters.CostToReroll.SetString(costToRerollRaw, 0); !ok {
+					return errors.New("--cost-to-reroll is not a valid integer")
+				}
+			}
+
+			needsClient := dbPath == "" || balanceSamples > 0 || parameters.CostToRoll == nil || parameters.CostToReroll == nil || parameters.BlocksToAct == 0
+			var ethClient *ethclient.Client
+			var client *JackpotJunction.JackpotJunction
+			if needsClient {
+				var clientErr, contractErr error
+				ethClient, clientErr = JackpotJunction.NewClient(rpc)
+				if clientErr != nil {
+					return clientErr
+				}
+
+				client, contractErr = JackpotJunction.NewJackpotJunction(contractAddress, ethClient)
+				if contractErr != nil {
+					return contractErr
+				}
+			}
+
+			if parameters.CostToRoll == nil || parameters.CostToReroll == nil || parameters.BlocksToAct == 0 {
+				ctx, cancel := JackpotJunction.NewChainContext(timeout)
+				defer cancel()
+				callOpts := bind.CallOpts{Context: ctx}
+
+				if parameters.CostToRoll == nil {
+					costToRoll, costErr := client.CostToRoll(&callOpts)
+					if costErr != nil {
+						return costErr
+					}
+					parameters.CostToRoll = costToRoll
+				}
+				if parameters.CostToReroll == nil {
+					costToReroll, costErr := client.CostToReroll(&callOpts)
+					if costErr != nil {
+						return costErr
+					}
+					parameters.CostToReroll = costToReroll
+				}
+				if parameters.BlocksToAct == 0 {
+					contractBlocksToAct, blocksErr := client.BlocksToAct(&callOpts)
+					if blocksErr != nil {
+						return blocksErr
+					}
+					parameters.BlocksToAct = contractBlocksToAct.Uint64()
+				}
+			}
+
+			var history analytics.History
+			if dbPath != "" {
+				db, dbErr := index.Open(dbPath)
+				if dbErr != nil {
+					return dbErr
+				}
+				defer db.Close()
+
+				checkErr := index.CheckContract(db, contractAddress)
+				if checkErr != nil {
+					return checkErr
+				}
+
+				var historyErr error
+				history, historyErr = analytics.FromIndex(db, start, end)
+				if historyErr != nil {
+					return historyErr
+				}
+			} else {
+				ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt)
+				defer cancel()
+
+				if end == 0 {
+					headCtx, cancelHead := JackpotJunction.NewChainContext(timeout)
+					head, headErr := ethClient.BlockNumber(headCtx)
+					cancelHead()
+					if headErr != nil {
+						return headErr
+					}
+					end = head
+				}
+
+				var historyErr error
+				history, historyErr = analytics.FromLogs(ctx, &client.JackpotJunctionFilterer, start, end, chunkSize)
+				if historyErr != nil {
+					return historyErr
+				}
+			}
+
+			report := analytics.Analyze(history, parameters, top)
+
+			if balanceSamples > 0 {
+				ctx, cancel := JackpotJunction.NewChainContext(timeout)
+				defer cancel()
+				sampleErr := analytics.SampleBalances(ctx, ethClient, contractAddress, &report, balanceSamples)
+				if sampleErr != nil {
+					cmd.PrintErrf("WARNING: could not read the balance of the contract at past blocks (an archive node is required): %s\n", sampleErr.Error())
+				}
+			}
+
+			if format == "json" {
+				encoder := json.NewEncoder(cmd.OutOrStdout())
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(report)
+			}
+
+			return analytics.WriteTables(cmd.OutOrStdout(), report)
+		},
+	}
+
+	analyticsCmd.Flags().StringVar(&rpc, "rpc", "", "URL of the JSONRPC API to use")
+	analyticsCmd.Flags().StringVar(&contractAddressRaw, "contract", "", "Address of the JackpotJunction contract")
+	analyticsCmd.Flags().StringVar(&dbPath, "db", "", "Path to a SQLite database created with \"jj index crawl\" (if not set, reads logs from the JSONRPC API)")
+	analyticsCmd.Flags().Uint64Var(&start, "start", 0, "First block to include in the report")
+	analyticsCmd.Flags().Uint64Var(&end, "end", 0, "Last block to include in the report (if 0, includes all blocks up to the current block)")
+	analyticsCmd.Flags().Uint64Var(&chunkSize, "chunk-size", 1000, "Number of blocks to request logs for at a time (when reading from the JSONRPC API)")
+	analyticsCmd.Flags().StringVar(&costToRollRaw, "cost-to-roll", "", "Cost to roll (in wei) -- if not set, read from the contract")
+	analyticsCmd.Flags().StringVar(&costToRerollRaw, "cost-to-reroll", "", "Cost to reroll (in wei) -- if not set, read from the contract")
+	analyticsCmd.Flags().Uint64Var(&blocksToAct, "blocks-to-act", 0, "Number of blocks a player has to act after a roll -- if not set, read from the contract")
+	analyticsCmd.Flags().Uint64Var(&balanceSamples, "balance-samples", 0, "Number of blocks at which to sample the balance of the contract")
+	analyticsCmd.Flags().IntVar(&top, "top", 10, "Number of top players to include in the report")
+	analyticsCmd.Flags().StringVar(&format, "format", "table", "Output format (table or json)")
+	analyticsCmd.Flags().UintVar(&timeout, "timeout", 60, "Timeout (in seconds) for interactions with the JSONRPC API")
+
+	return analyticsCmd
+}
+
+func CreateLeaderboardCommand() *cobra.Command {
+	leaderboardCmd := &cobra.Command{
+		Use:   "leaderboard",
+		Short: "Build JackpotJunction leaderboards: biggest jackpots, most items earned, highest tier crafted, most rolls",
+		Long: `Build JackpotJunction leaderboards: biggest jackpots, most items earned, highest tier crafted, most rolls.
+
+Leaderboards are built from the Roll, Award, TierUnlocked, and ERC1155 transfer events emitted by the contract
+over a window of blocks. The window is either given explicitly with --start and --end, or as a duration ending
+at the current block with --window (e.g. --window 168h for the last week).`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	exportCmd := CreateLeaderboardExportCommand()
+	serveCmd := CreateLeaderboardServeCommand()
+	leaderboardCmd.AddCommand(exportCmd, serveCmd)
+
+	return leaderboardCmd
+}
+
+// Flags shared by the leaderboard commands.
+type leaderboardFlags struct {
+	rpc, contractAddressRaw string
+	contractAddress         common.Address
+	start, end, chunkSize   uint64
+	window                  time.Duration
+	limit                   int
+	timeout                 uint
+}
+
+func (flags *leaderboardFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&flags.rpc, "rpc", "", "URL of the JSONRPC API to use")
+	cmd.Flags().StringVar(&flags.contractAddressRaw, "contract", "", "Address of the JackpotJunction contract")
+	cmd.Flags().Uint64Var(&flags.start, "start", 0, "First block of the window")
+	cmd.Flags().Uint64Var(&flags.end, "end", 0, "Last block of the window (if 0, the current block)")
+	cmd.Flags().DurationVar(&flags.window, "window", 0, "Duration of the window, ending at the current block (overrides --start and --end)")
+	cmd.Flags().Uint64Var(&flags.chunkSize, "chunk-size", 1000, "Number of blocks to request logs for at a time")
+	cmd.Flags().IntVar(&flags.limit, "limit", 100, "Number of entries in each leaderboard (if 0, all entries are included)")
+	cmd.Flags().UintVar(&flags.timeout, "timeout", 60, "Timeout (in seconds) for interactions with the JSONRPC API")
+}
+
+func (flags *leaderboardFlags) validate() error {
+	if flags.contractAddressRaw == "" {
+		return errors.New("--contract is required")
+	} else if !common.IsHexAddress(flags.contractAddressRaw) {
+		return errors.New("--contract is not a valid Ethereum address")
+	}
+	flags.contractAddress = common.HexToAddress(flags.contractAddressRaw)
+
+	if flags.window == 0 && flags.end != 0 && flags.end < flags.start {
+		return errors.New("--end must not be smaller than --start")
+	}
+
+	return nil
+}
+
+// Builds the leaderboards for the window described by the flags. Each request to the JSON-RPC API is given
+// at most --timeout seconds to complete.
+func (flags *leaderboardFlags) build(ctx context.Context, client *ethclient.Client) (leaderboard.Leaderboards, error) {
+	timeout := time.Duration(flags.timeout) * time.Second
+
+	fromBlock, toBlock := flags.start, flags.end
+	if flags.window != 0 {
+		var windowErr error
+		fromBlock, toBlock, windowErr = leaderboard.Window(ctx, client, flags.window, timeout)
+		if windowErr != nil {
+			return leaderboard.Leaderboards{}, windowErr
+		}
+	} else if toBlock == 0 {
+		headCtx, cancel := JackpotJunction.NewChainContext(flags.timeout)
+		defer cancel()
+		head, headErr := client.BlockNumber(headCtx)
+		if headErr != nil {
+			return leaderboard.Leaderboards{}, headErr
+		}
+		toBlock = head
+	}
+
+	return leaderboard.Build(ctx, client, flags.contractAddress, fromBlock, toBlock, flags.chunkSize, flags.limit, timeout)
+}
+
+func CreateLeaderboardExportCommand() *cobra.Command {
+	var flags leaderboardFlags
+	var outfile string
+
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the leaderboards as JSON",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return flags.validate()
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, clientErr := JackpotJunction.NewClient(flags.rpc)
+			if clientErr != nil {
+				return clientErr
+			}
+
+			leaderboards, buildErr := flags.build(cmd.Context(), client)
+			if buildErr != nil {
+				return buildErr
+			}
+
+			output := cmd.OutOrStdout()
+			if outfile != "" {
+				file, fileErr := os.Create(outfile)
+				if fileErr != nil {
+					return fileErr
+				}
+				defer file.Close()
+				output = file
+			}
+
+			encoder := json.NewEncoder(output)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(leaderboards)
+		},
+	}
+
+	flags.register(exportCmd)
+	exportCmd.Flags().StringVarP(&outfile, "output", "o", "", "File to write the leaderboards to (if not set, writes to stdout)")
+
+	return exportCmd
+}
+
+func CreateLeaderboardServeCommand() *cobra.Command {
+	var flags leaderboardFlags
+	var addr string
+	var refresh uint
+
+	serveCmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve the leaderboards over HTTP",
+		Long: `Serve the leaderboards over HTTP.
+
+The server rebuilds the leaderboards every --refresh seconds and serves them as JSON:
+
+  GET /leaderboards         - all leaderboards
+  GET /leaderboards/{name}  - a single leaderboard (jackpots, items, tiers, or rolls)
+
+Use --window for leaderboards over a rolling window of time. With --start and no --end, each refresh extends
+the window to the current block.`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return flags.validate()
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, clientErr := JackpotJunction.NewClient(flags.rpc)
+			if clientErr != nil {
+				return clientErr
+			}
+
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer cancel()
+
+			server := &leaderboard.Server{}
+			refreshLeaderboards := func() {
+				leaderboards, buildErr := flags.build(ctx, client)
+				if buildErr != nil {
+					cmd.PrintErrf("Error building leaderboards: %s\n", buildErr.Error())
+					return
+				}
+				server.Update(leaderboards)
+				cmd.Printf("Built leaderboards for blocks %d-%d\n", leaderboards.FromBlock, leaderboards.ToBlock)
+			}
+
+			go func() {
+				for {
+					refreshLeaderboards()
+					select {
+					case <-ctx.Done():
+						return
+					case <-time.After(time.Duration(refresh) * time.Second):
+					}
+				}
+			}()
+
+			httpServer := &http.Server{Addr: addr, Handler: server}
+			go func() {
+				<-ctx.Done()
+				httpServer.Close()
+			}()
+
+			cmd.Printf("Serving leaderboards on %s\n", addr)
+			serveErr := httpServer.ListenAndServe()
+			if errors.Is(serveErr, http.ErrServerClosed) {
+				return nil
+			}
+			return serveErr
+		},
+	}
+
+	flags.register(serveCmd)
+	serveCmd.Flags().StringVar(&addr, "addr", "127.0.0.1:8080", "Address to listen on")
+	serveCmd.Flags().UintVar(&refresh, "refresh", 60, "Time (in seconds) between rebuilds of the leaderboards")
+
+	return serveCmd
+}
+
+func CreateWatchCommand() *cobra.Command {
+	var rpc, contractAddressRaw, checkpointFile, format string
+	var contractAddress common.Address
+	var playersRaw, events []string
+	var outcomesRaw, tiersRaw []uint
+	var start, chunkSize uint64
+	var poll bool
+	var pollInterval, retryInterval, timeout uint
+	var filter watch.Filter
+
+	watchCmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Stream Roll, Award, and TierUnlocked events as they happen",
+		Long: `Stream Roll, Award, and TierUnlocked events as they happen.
+
+If --rpc is a websocket (ws:// or wss://) URL, events are streamed through a subscription. Otherwise (or if
+--poll is set), the contract logs are polled with eth_getLogs every --poll-interval seconds.
+
+Events can be filtered by --event, --player (Roll and Award), --outcome (Award), and --tier (TierUnlocked).
+They are printed one per line, either as text or (with --format json) as newline-delimited JSON objects.
+
+If --checkpoint is set, the position in the event stream is stored in that file after each event, and the
+watcher resumes from it when it is restarted. The watcher also resumes from its last position whenever it
+reconnects after losing its connection to the JSONRPC API. Without a checkpoint, it starts at --start (or at
+the current block if --start is 0).
+
+When streaming through a subscription, events that are removed from the chain by a reorg are printed again
+with "removed" set to true.`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if contractAddressRaw == "" {
+				return errors.New("--contract is required")
+			} else if !common.IsHexAddress(contractAddressRaw) {
+				return errors.New("--contract is not a valid Ethereum address")
+			}
+			contractAddress = common.HexToAddress(contractAddressRaw)
+
+			if format != "text" && format != "json" {
+				return errors.New("--format must be one of: text, json")
+			}
+
+			filter = watch.Filter{Events: events}
+			for _, playerRaw := range playersRaw {
+				if !common.IsHexAddress(playerRaw) {
+					return errors.New("--player is not a valid Ethereum address: " + playerRaw)
+				}
+				filter.Players = append(filter.Players, common.HexToAddress(playerRaw))
+			}
+			for _, outcome := range outcomesRaw {
+				filter.Outcomes = append(filter.Outcomes, new(big.Int).SetUint64(uint64(outcome)))
+			}
+			for _, tier := range tiersRaw {
+				filter.Tiers = append(filter.Tiers, new(big.Int).SetUint64(uint64(tier)))
+			}
+
+			return filter.Validate()
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			checkpoint := watch.Checkpoint{NextBlock: start}
+			if checkpointFile != "" {
+				stored, ok, loadErr := watch.LoadCheckpoint(checkpointFile)
+				if loadErr != nil {
+					return loadErr
+				}
+				if ok {
+					checkpoint = stored
+				}
+			}
+
+			if rpc == "" {
+				rpc = os.Getenv("JACKPOT_JUNCTION_RPC_URL")
+			}
+			subscribe := !poll && (strings.HasPrefix(rpc, "ws://") || strings.HasPrefix(rpc, "wss://"))
+
+			encoder := json.NewEncoder(cmd.OutOrStdout())
+			watcher := watch.Watcher{
+				Dial: func(ctx context.Context) (watch.Backend, error) {
+					return JackpotJunction.NewClient(rpc)
+				},
+				Contract:      contractAddress,
+				Filter:        filter,
+				Subscribe:     subscribe,
+				ChunkSize:     chunkSize,
+				PollInterval:  time.Duration(pollInterval) * time.Second,
+				RetryInterval: time.Duration(retryInterval) * time.Second,
+				Timeout:       time.Duration(timeout) * time.Second,
+				Handle: func(event watch.Event) error {
+					if format == "json" {
+						return encoder.Encode(event)
+					}
+					prefix := ""
+					if event.Removed {
+						prefix = "REMOVED "
+					}
+					_, printErr := fmt.Fprintf(cmd.OutOrStdout(), "%s[block %d, tx %s] %s\n", prefix, event.BlockNumber, event.TransactionHash.Hex(), DescribeEvent(event.Decoded))
+					return printErr
+				},
+				Disconnected: func(err error) {
+					cmd.PrintErrf("Lost connection to the JSONRPC API (%s), reconnecting in %d seconds\n", err.Error(), retryInterval)
+				},
+			}
+			if checkpointFile != "" {
+				watcher.Save = func(checkpoint watch.Checkpoint) error {
+					return watch.SaveCheckpoint(checkpointFile, checkpoint)
+				}
+			}
+
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer cancel()
+
+			runErr := watcher.Run(ctx, checkpoint)
+			if errors.Is(runErr, context.Canceled) {
+				return nil
+			}
+			return runErr
+		},
+	}
+
+	watchCmd.Flags().StringVar(&rpc, "rpc", "", "URL of the JSONRPC API to use (use a ws:// or wss:// URL to stream events through a subscription)")
+	watchCmd.Flags().StringVar(&contractAddressRaw, "contract", "", "Address of the JackpotJunction contract")
+	watchCmd.Flags().StringSliceVar(&events, "event", nil, "Events to watch: Roll, Award, TierUnlocked (default: all; may be repeated or comma-separated)")
+	watchCmd.Flags().StringSliceVarP(&playersRaw, "player", "p", nil, "Only show Roll and Award events for these players")
+	watchCmd.Flags().UintSliceVar(&outcomesRaw, "outcome", nil, "Only show Award events with these outcomes")
+	watchCmd.Flags().UintSliceVar(&tiersRaw, "tier", nil, "Only show TierUnlocked events for these tiers")
+	watchCmd.Flags().StringVar(&format, "format", "text", "Output format (text or json)")
+	watchCmd.Flags().StringVar(&checkpointFile, "checkpoint", "", "File in which to store the position in the event stream, to resume from on restart")
+	watchCmd.Flags().Uint64Var(&start, "start", 0, "Block to start watching from if there is no checkpoint (if 0, the current block)")
+	watchCmd.Flags().Uint64Var(&chunkSize, "chunk-size", 1000, "Number of blocks to request logs for at a time when catching up")
+	watchCmd.Flags().BoolVar(&poll, "poll", false, "Poll with eth_getLogs even if --rpc is a websocket URL")
+	watchCmd.Flags().UintVar(&pollInterval, "poll-interval", 5, "Time (in seconds) between polls")
+	watchCmd.Flags().UintVar(&retryInterval, "retry-interval", 5, "Time (in seconds) to wait before reconnecting to the JSONRPC API")
+	watchCmd.Flags().UintVar(&timeout, "timeout", 60, "Timeout (in seconds) for each request to the JSONRPC API")
+
+	return watchCmd
+}
+
+func CreateNotifyCommand() *cobra.Command {
+	var rpc, contractAddressRaw, configFile, checkpointFile, seenFile string
+	var contractAddress common.Address
+	var webhookURLs []string
+	var start, chunkSize uint64
+	var poll bool
+	var pollInterval, retryInterval, timeout, retryDelay uint
+	var maxAttempts int
+	var webhooks []notify.Webhook
+
+	notifyCmd := &cobra.Command{
+		Use:   "notify",
+		Short: "POST announcements of jackpots and tier unlocks to webhooks",
+		Long: `POST announcements of jackpots and tier unlocks to webhooks.
+
+The notifier follows Award events with outcome 4 (jackpots) and TierUnlocked events the same way as "jj watch"
+does, and POSTs a JSON payload to each configured webhook for each of them. Failed requests are retried with
+exponential backoff.
+
+Webhooks are configured either with --webhook (which uses the default templates, compatible with Discord and
+Slack) or with a JSON --config file:
+
+  {
+    "webhooks": [
+      {
+        "url": "https://example.com/hook",
+        "events": ["jackpot", "tier_unlocked"],
+        "headers": {"Authorization": "Bearer ..."},
+        "templates": {
+          "jackpot": "{\"text\": {{ printf \"%s won %s ETH\" .Player.Hex .ValueEther | json }}}",
+          "tier_unlocked": "@tier.json.tmpl"
+        }
+      }
+    ]
+  }
+
+Templates are Go text/template templates which must produce JSON. They are rendered with the fields Kind,
+Contract, BlockNumber, LogIndex, TransactionHash, Player, Value, ValueEther (jackpots), and ItemType,
+TerrainType, Tier, PoolID, Item (tier unlocks). The json function quotes a value as JSON, and the ether
+function formats an amount in wei as ETH.
+
+Notifications are deduplicated by transaction hash and log index for each webhook. Use --seen to keep the
+deduplication state across restarts, and --checkpoint to resume following events where the notifier left off.`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if contractAddressRaw == "" {
+				return errors.New("--contract is required")
+			} else if !common.IsHexAddress(contractAddressRaw) {
+				return errors.New("--contract is not a valid Ethereum address")
+			}
+			contractAddress = common.HexToAddress(contractAddressRaw)
+
+			config := notify.Config{}
+			baseDir := "."
+			if configFile != "" {
+				var configErr error
+				config, configErr = notify.LoadConfig(configFile)
+				if configErr != nil {
+					return configErr
+				}
+				baseDir = filepath.Dir(configFile)
+			}
+			for _, webhookURL := range webhookURLs {
+				config.Webhooks = append(config.Webhooks, notify.WebhookConfig{URL: webhookURL})
+			}
+
+			var webhooksErr error
+			webhooks, webhooksErr = config.Build(baseDir)
+			return webhooksErr
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			checkpoint := watch.Checkpoint{NextBlock: start}
+			if checkpointFile != "" {
+				stored, ok, loadErr := watch.LoadCheckpoint(checkpointFile)
+				if loadErr != nil {
+					return loadErr
+				}
+				if ok {
+					checkpoint = stored
+				}
+			}
+
+			seen := notify.NewSeen()
+			if seenFile != "" {
+				var seenErr error
+				seen, seenErr = notify.OpenSeen(seenFile)
+				if seenErr != nil {
+					return seenErr
+				}
+			}
+			defer seen.Close()
+
+			notifier := notify.Notifier{
+				Contract:    contractAddress,
+				Webhooks:    webhooks,
+				Client:      &http.Client{Timeout: time.Duration(timeout) * time.Second},
+				Seen:        seen,
+				MaxAttempts: maxAttempts,
+				RetryDelay:  time.Duration(retryDelay) * time.Second,
+				Delivered: func(notification notify.Notification, webhook notify.Webhook) {
+					cmd.Printf("Delivered %s notification for %s:%d to %s\n", notification.Kind, notification.TransactionHash.Hex(), notification.LogIndex, webhook.URL)
+				},
+				Failed: func(notification notify.Notification, webhook notify.Webhook, err error) {
+					cmd.PrintErrf("Failed to deliver %s notification for %s:%d to %s: %s\n", notification.Kind, notification.TransactionHash.Hex(), notification.LogIndex, webhook.URL, err.Error())
+				},
+			}
+
+			if rpc == "" {
+				rpc = os.Getenv("JACKPOT_JUNCTION_RPC_URL")
+			}
+			subscribe := !poll && (strings.HasPrefix(rpc, "ws://") || strings.HasPrefix(rpc, "wss://"))
+
+			watcher := watch.Watcher{
+				Dial: func(ctx context.Context) (watch.Backend, error) {
+					return JackpotJunction.NewClient(rpc)
+				},
+				Contract: contractAddress,
+				Filter: watch.Filter{
+					Events:   []string{watch.EventAward, watch.EventTierUnlocked},
+					Outcomes: []*big.Int{big.NewInt(4)},
+				},
+				Subscribe:     subscribe,
+				ChunkSize:     chunkSize,
+				PollInterval:  time.Duration(pollInterval) * time.Second,
+				RetryInterval: time.Duration(retryInterval) * time.Second,
+				Timeout:       time.Duration(timeout) * time.Second,
+				Disconnected: func(err error) {
+					cmd.PrintErrf("Lost connection to the JSONRPC API (%s), reconnecting in %d seconds\n", err.Error(), retryInterval)
+				},
+			}
+			if checkpointFile != "" {
+				watcher.Save = func(checkpoint watch.Checkpoint) error {
+					return watch.SaveCheckpoint(checkpointFile, checkpoint)
+				}
+			}
+
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer cancel()
+
+			watcher.Handle = func(event watch.Event) error {
+				return notifier.Handle(ctx, event)
+			}
+
+			runErr := watcher.Run(ctx, checkpoint)
+			if errors.Is(runErr, context.Canceled) {
+				return nil
+			}
+			return runErr
+		},
+	}
+
+	notifyCmd.Flags().StringVar(&rpc, "rpc", "", "URL of the JSONRPC API to use (use a ws:// or wss:// URL to follow events through a subscription)")
+	notifyCmd.Flags().StringVar(&contractAddressRaw, "contract", "", "Address of the JackpotJunction contract")
+	notifyCmd.Flags().StringVar(&configFile, "config", "", "JSON file configuring the webhooks and their templates")
+	notifyCmd.Flags().StringSliceVar(&webhookURLs, "webhook", nil, "URL of a webhook to notify with the default templates (may be repeated)")
+	notifyCmd.Flags().StringVar(&checkpointFile, "checkpoint", "", "File in which to store the position in the event stream, to resume from on restart")
+	notifyCmd.Flags().StringVar(&seenFile, "seen", "", "File in which to store the notifications that were already delivered")
+	notifyCmd.Flags().Uint64Var(&start, "start", 0, "Block to start following events from if there is no checkpoint (if 0, the current block)")
+	notifyCmd.Flags().Uint64Var(&chunkSize, "chunk-size", 1000, "Number of blocks to request logs for at a time when catching up")
+	notifyCmd.Flags().BoolVar(&poll, "poll", false, "Poll with eth_getLogs even if --rpc is a websocket URL")
+	notifyCmd.Flags().UintVar(&pollInterval, "poll-interval", 5, "Time (in seconds) between polls")
+	notifyCmd.Flags().UintVar(&retryInterval, "retry-interval", 5, "Time (in seconds) to wait before reconnecting to the JSONRPC API")
+	notifyCmd.Flags().IntVar(&maxAttempts, "max-attempts", 5, "Number of attempts to deliver each notification")
+	notifyCmd.Flags().UintVar(&retryDelay, "retry-delay", 1, "Time (in seconds) to wait before retrying a failed delivery (doubles after each retry)")
+	notifyCmd.Flags().UintVar(&timeout, "timeout", 60, "Timeout (in seconds) for each request to the JSONRPC API and to webhooks")
+
+	return notifyCmd
+}
+
+func CreateSimulateCommand() *cobra.Command {
+	simulateCmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "Simulate the JackpotJunction game",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	economyCmd := CreateSimulateEconomyCommand()
+	simulateCmd.AddCommand(economyCmd)
+
+	return simulateCmd
+}
+
+func CreateSimulateEconomyCommand() *cobra.Command {
+	var rpc, contractAddressRaw, costToRollRaw, costToRerollRaw, balanceRaw, drainThresholdRaw, format string
+	var strategiesRaw []string
+	var blocksToAct, blocks uint64
+	var runs, points int
+	var seed int64
+	var timeout uint
+	var strategies []economy.Strategy
+
+	economyCmd := &cobra.Command{
+		Use:   "economy",
+		Short: "Monte Carlo simulation of the JackpotJunction reward pool",
+		Long: `Monte Carlo simulation of the JackpotJunction reward pool.
+
+The rewards that JackpotJunction pays out depend on its balance: the small reward is 1.5 * CostToRoll capped
+at balance/64, the medium reward is balance/64, and the jackpot is balance/2. This command simulates the
+balance of the contract over --blocks blocks, --runs times, starting from --balance, and reports the balance
+trajectory, the variance of payouts, the time to the first jackpot, and the probability that the balance
+drains below --drain-threshold.
+
+Players are described by --strategy, which may be repeated to simulate groups of players who play
+differently. A strategy is a comma-separated list of key=value pairs:
+
+  players  Number of players in the group (default 10)
+  rate     Probability that an idle player rolls in any given block (default 0.05)
+  accept   Smallest outcome that the players accept without rerolling (default 0)
+  rerolls  Maximum number of times that the players reroll before accepting (default 0)
+  bonus    Whether the players have the bonus, i.e. roll with the improved distribution (default false)
+
+For example: --strategy players=20,rate=0.01 --strategy players=5,rate=0.1,accept=2,rerolls=5,bonus=true
+
+If --contract is set, the parameters and the initial balance are read from the contract. Flags which are set
+explicitly override the values read from the contract.`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if contractAddressRaw != "" && !common.IsHexAddress(contractAddressRaw) {
+				return errors.New("--contract is not a valid Ethereum address")
+			}
+
+			if contractAddressRaw == "" && (costToRollRaw == "" || costToRerollRaw == "" || balanceRaw == "") {
+				return errors.New("--cost-to-roll, --cost-to-reroll, and --balance are required if --contract is not set")
+			}
+
+			if format != "table" && format != "json" {
+				return errors.New("--format must be one of: table, json")
+			}
+
+			if runs <= 0 {
+				return errors.New("--runs must be positive")
+			}
+
+			if len(strategiesRaw) == 0 {
+				strategies = []economy.Strategy{economy.DefaultStrategy}
+			}
+			for _, strategyRaw := range strategiesRaw {
+				strategy, strategyErr := economy.ParseStrategy(strategyRaw)
+				if strategyErr != nil {
+					return strategyErr
+				}
+				strategies = append(strategies, strategy)
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parameters := economy.Parameters{
+				UnmodifiedOutcomesCumulativeMass: economy.UnmodifiedOutcomesCumulativeMass,
+				ImprovedOutcomesCumulativeMass:   economy.ImprovedOutcomesCumulativeMass,
+			}
+			var initialBalance *big.Int
+
+			if contractAddressRaw != "" {
+				client, clientErr := JackpotJunction.NewClient(rpc)
+				if clientErr != nil {
+					return clientErr
+				}
+
+				contractAddress := common.HexToAddress(contractAddressRaw)
+				contract, contractErr := JackpotJunction.NewJackpotJunction(contractAddress, client)
+				if contractErr != nil {
+					return contractErr
+				}
+
+				ctx, cancel := JackpotJunction.NewChainContext(timeout)
+				defer cancel()
+
+				var parametersErr error
+				parameters, parametersErr = economy.ReadParameters(&contract.JackpotJunctionCaller, &bind.CallOpts{Context: ctx})
+				if parametersErr != nil {
+					return parametersErr
+				}
+
+				var balanceErr error
+				initialBalance, balanceErr = client.BalanceAt(ctx, contractAddress, nil)
+				if balanceErr != nil {
+					return balanceErr
+				}
+			}
+
+			for _, override := range []struct {
+				raw    string
+				flag   string
+				target **big.Int
+			}{
+				{costToRollRaw, "--cost-to-roll", &parameters.CostToRoll},
+				{costToRerollRaw, "--cost-to-reroll", &parameters.CostToReroll},
+				{balanceRaw, "--balance", &initialBalance},
+			} {
+				if override.raw == "" {
+					continue
+				}
+				value, ok := new(big.Int).SetString(override.raw, 0)
+				if !ok || value.Sign() < 0 {
+					return errors.New(override.flag + " is not a valid amount of wei")
+				}
+				*override.target = value
+			}
+			if contractAddressRaw == "" || cmd.Flags().Changed("blocks-to-act") {
+				parameters.BlocksToAct = blocksToAct
+			}
+
+			// By default, a run counts as drained if it loses 90% of its initial balance.
+			drainThreshold := new(big.Int).Div(initialBalance, big.NewInt(10))
+			if drainThresholdRaw != "" {
+				var ok bool
+				drainThreshold, ok = new(big.Int).SetString(drainThresholdRaw, 0)
+				if !ok {
+					return errors.New("--drain-threshold is not a valid amount of wei")
+				}
+			}
+
+			result, simulateErr := economy.Simulate(economy.Simulation{
+				Parameters:       parameters,
+				InitialBalance:   initialBalance,
+				Strategies:       strategies,
+				Blocks:           blocks,
+				Runs:             runs,
+				DrainThreshold:   drainThreshold,
+				TrajectoryPoints: points,
+				Seed:             seed,
+			})
+			if simulateErr != nil {
+				return simulateErr
+			}
+
+			if format == "json" {
+				encoder := json.NewEncoder(cmd.OutOrStdout())
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(result)
+			}
+
+			return economy.WriteSimulationTables(cmd.OutOrStdout(), result)
+		},
+	}
+
+	economyCmd.Flags().StringVar(&rpc, "rpc", "", "URL of the JSONRPC API to use (only used with --contract)")
+	economyCmd.Flags().StringVar(&contractAddressRaw, "contract", "", "Address of a JackpotJunction contract to read parameters and the initial balance from")
+	economyCmd.Flags().StringVar(&costToRollRaw, "cost-to-roll", "", "Cost to roll (in wei)")
+	economyCmd.Flags().StringVar(&costToRerollRaw, "cost-to-reroll", "", "Cost to reroll (in wei)")
+	economyCmd.Flags().Uint64Var(&blocksToAct, "blocks-to-act", 20, "Number of blocks a player has to act after a roll (read from the contract if --contract is set)")
+	economyCmd.Flags().StringVar(&balanceRaw, "balance", "", "Initial balance of the contract (in wei)")
+	economyCmd.Flags().StringVar(&drainThresholdRaw, "drain-threshold", "", "A run counts as drained if the balance falls below this amount of wei (default: 10% of the initial balance)")
+	economyCmd.Flags().StringArrayVar(&strategiesRaw, "strategy", nil, "Strategy for a group of players (may be repeated)")
+	economyCmd.Flags().Uint64Var(&blocks, "blocks", 10000, "Number of blocks to simulate in each run")
+	economyCmd.Flags().IntVar(&runs, "runs", 1000, "Number of runs")
+	economyCmd.Flags().IntVar(&points, "points", 11, "Number of points in the balance trajectory")
+	economyCmd.Flags().Int64Var(&seed, "seed", 1, "Seed for the random number generator")
+	economyCmd.Flags().StringVar(&format, "format", "table", "Output format (table or json)")
+	economyCmd.Flags().UintVar(&timeout, "timeout", 60, "Timeout (in seconds) for interactions with the JSONRPC API")
+
+	return economyCmd
+}
+
+func CreateEVCommand() *cobra.Command {
+	var rpc, contractAddressRaw, playerRaw, blockNumberRaw, costToRollRaw, costToRerollRaw, balanceRaw, pricesFile, itemPriceRaw, format string
+	var contractAddress common.Address
+	var bonus bool
+	var outcome int
+	var timeout uint
+
+	evCmd := &cobra.Command{
+		Use:   "ev",
+		Short: "Expected value of rolling and rerolling, and the optimal policy for accepting outcomes",
+		Long: `Expected value of rolling and rerolling, and the optimal policy for accepting outcomes.
+
+This command reads CostToRoll, CostToReroll, the outcome distributions, and the balance of the JackpotJunction
+contract, and computes the expected value (in ETH, net of fees) of a fresh roll and of a reroll. It also
+computes the expected value of each policy of the form "accept the first outcome which is at least k, and
+reroll otherwise", and recommends the best one.
+
+If --player is set, the command uses the player's bonus status and, if the player has rolled and can still
+act, shows whether they should accept or reroll the outcome that they currently see.
+
+Items have no intrinsic value in wei. Use --item-price to value all items at the same price, or --prices for
+a JSON file mapping item names to prices in wei (with an optional "default" key), for example:
+
+  {"t0-forest-wheels": "2000000000000000", "default": "1000000000000000"}`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if contractAddressRaw == "" {
+				return errors.New("--contract is required")
+			} else if !common.IsHexAddress(contractAddressRaw) {
+				return errors.New("--contract is not a valid Ethereum address")
+			}
+			contractAddress = common.HexToAddress(contractAddressRaw)
+
+			if playerRaw != "" && !common.IsHexAddress(playerRaw) {
+				return errors.New("--player is not a valid Ethereum address")
+			}
+
+			if format != "table" && format != "json" {
+				return errors.New("--format must be one of: table, json")
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prices := economy.PriceTable{}
+			if pricesFile != "" {
+				var pricesErr error
+				prices, pricesErr = economy.LoadPriceTable(pricesFile)
+				if pricesErr != nil {
+					return pricesErr
+				}
+			}
+			if itemPriceRaw != "" {
+				itemPrice, ok := new(big.Int).SetString(itemPriceRaw, 0)
+				if !ok || itemPrice.Sign() < 0 {
+					return errors.New("--item-price is not a valid amount of wei")
+				}
+				prices.Default = itemPrice
+			}
+
+			client, clientErr := JackpotJunction.NewClient(rpc)
+			if clientErr != nil {
+				return clientErr
+			}
+
+			contract, contractErr := JackpotJunction.NewJackpotJunction(contractAddress, client)
+			if contractErr != nil {
+				return contractErr
+			}
+
+			ctx, cancel := JackpotJunction.NewChainContext(timeout)
+			defer cancel()
+			callOpts := bind.CallOpts{Context: ctx}
+			JackpotJunction.SetCallParametersFromArgs(&callOpts, false, "", blockNumberRaw)
+
+			parameters, parametersErr := economy.ReadParameters(&contract.JackpotJunctionCaller, &callOpts)
+			if parametersErr != nil {
+				return parametersErr
+			}
+
+			balance, balanceErr := client.BalanceAt(ctx, contractAddress, callOpts.BlockNumber)
+			if balanceErr != nil {
+				return balanceErr
+			}
+
+			for _, override := range []struct {
+				raw    string
+				flag   string
+				target **big.Int
+			}{
+				{costToRollRaw, "--cost-to-roll", &parameters.CostToRoll},
+				{costToRerollRaw, "--cost-to-reroll", &parameters.CostToReroll},
+				{balanceRaw, "--balance", &balance},
+			} {
+				if override.raw == "" {
+					continue
+				}
+				value, ok := new(big.Int).SetString(override.raw, 0)
+				if !ok || value.Sign() < 0 {
+					return errors.New(override.flag + " is not a valid amount of wei")
+				}
+				*override.target = value
+			}
+
+			// The outcome that the player currently sees, if any.
+			preview := -1
+			if playerRaw != "" {
+				player := common.HexToAddress(playerRaw)
+				if !cmd.Flags().Changed("bonus") {
+					hasBonus, hasBonusErr := contract.HasBonus(&callOpts, player)
+					if hasBonusErr != nil {
+						return hasBonusErr
+					}
+					bonus = hasBonus
+				}
+
+				// outcome reverts if the player has not rolled, has to wait for the next block, or missed their
+				// deadline. In all of those cases, there is no outcome to accept or reroll.
+				_, currentOutcome, _, outcomeErr := contract.Outcome(&callOpts, player, bonus)
+				if outcomeErr == nil {
+					preview = int(currentOutcome.Int64())
+				}
+			}
+			if outcome >= 0 {
+				preview = outcome
+			}
+
+			values := economy.ComputeExpectedValues(parameters, balance, bonus, prices)
+
+			if format == "json" {
+				encoder := json.NewEncoder(cmd.OutOrStdout())
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(values)
+			}
+
+			writeErr := economy.WriteExpectedValuesTables(cmd.OutOrStdout(), values)
+			if writeErr != nil {
+				return writeErr
+			}
+
+			if preview >= 0 && preview < economy.NumOutcomes {
+				action := "accept"
+				if values.ShouldReroll(uint64(preview)) {
+					action = "reroll"
+				}
+				cmd.Printf("\nCurrent outcome: %d -- %s\n", preview, action)
+			}
+
+			return nil
+		},
+	}
+
+	evCmd.Flags().StringVar(&rpc, "rpc", "", "URL of the JSONRPC API to use")
+	evCmd.Flags().StringVar(&contractAddressRaw, "contract", "", "Address of the JackpotJunction contract")
+	evCmd.Flags().StringVarP(&playerRaw, "player", "p", "", "Address of the player (to read their bonus status and current outcome)")
+	evCmd.Flags().StringVar(&blockNumberRaw, "block", "", "Block number at which to read the contract state")
+	evCmd.Flags().BoolVar(&bonus, "bonus", false, "Whether the player has the bonus (read from the contract if --player is set)")
+	evCmd.Flags().IntVar(&outcome, "outcome", -1, "Outcome that the player currently sees (read from the contract if --player is set)")
+	evCmd.Flags().StringVar(&costToRollRaw, "cost-to-roll", "", "Override the cost to roll (in wei)")
+	evCmd.Flags().StringVar(&costToRerollRaw, "cost-to-reroll", "", "Override the cost to reroll (in wei)")
+	evCmd.Flags().StringVar(&balanceRaw, "balance", "", "Override the balance of the contract (in wei)")
+	evCmd.Flags().StringVar(&pricesFile, "prices", "", "JSON file mapping item names to prices (in wei)")
+	evCmd.Flags().StringVar(&itemPriceRaw, "item-price", "", "Price (in wei) of every item which is not in --prices")
+	evCmd.Flags().StringVar(&format, "format", "table", "Output format (table or json)")
+	evCmd.Flags().UintVar(&timeout, "timeout", 60, "Timeout (in seconds) for interactions with the JSONRPC API")
+
+	return evCmd
+}
+
+// Waits for the given transaction to be mined and returns an error if it was reverted.
+func waitForSuccess(client bind.DeployBackend, transaction *types.Transaction, timeout uint) error {
+	minedCtx, cancelMinedCtx := JackpotJunction.NewChainContext(timeout)
+	defer cancelMinedCtx()
+
+	receipt, receiptErr := bind.WaitMined(minedCtx, client, transaction)
+	if receiptErr != nil {
+		return receiptErr
+	}
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return errors.New("transaction reverted: " + transaction.Hash().Hex())
+	}
+
+	return nil
+}
+
+// ApplyProfile sets the flags of cmd which were not passed on the command line to the values from the active
+// profile in the jj configuration file. The flags are set without being marked as changed, so the profile
+// behaves exactly like a set of flag defaults. The RPC URL from the profile is not used if the
+// JACKPOT_JUNCTION_RPC_URL environment variable is set.
+func ApplyProfile(cmd *cobra.Command, profileName string) error {
+	configPath, pathErr := config.DefaultPath()
+	if pathErr != nil {
+		// Without a configuration file, the only way to select a profile is by name.
+		if profileName != "" {
+			return pathErr
+		}
+		return nil
+	}
+
+	jjConfig, loadErr := config.Load(configPath)
+	if loadErr != nil {
+		return loadErr
+	}
+
+	_, profile, profileErr := jjConfig.Active(profileName)
+	if profileErr != nil {
+		return profileErr
+	}
+
+	for flagName, value := range profile.FlagDefaults() {
+		if flagName == "rpc" && os.Getenv("JACKPOT_JUNCTION_RPC_URL") != "" {
+			continue
+		}
+
+		flag := cmd.Flags().Lookup(flagName)
+		if flag == nil || flag.Changed {
+			continue
+		}
+
+		setErr := flag.Value.Set(value)
+		if setErr != nil {
+			return fmt.Errorf("invalid value for %s in profile: %s", flagName, setErr.Error())
+		}
+	}
+
+	return nil
+}
+
+func CreateConfigCommand() *cobra.Command {
+	configCmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage the jj configuration file and its profiles",
+		Long: `Manage the jj configuration file and its profiles.
+
+Each profile holds defaults for a chain and deployment of the JackpotJunction contract: RPC URL, contract
+address, keystore file, gas settings and timeout. Commands take the values of flags which are not passed on
+the command line from the active profile. The active profile is the one passed with --profile, the one named
+by the JJ_PROFILE environment variable, or the default profile, in that order.
+
+The configuration file is ~/.config/jj/config.yaml by default (or the equivalent user configuration
+directory on your platform). Set the JJ_CONFIG environment variable to use a different file.
+
+Profile keys: ` + strings.Join(config.Keys, ", "),
+		// The configuration commands must work even if the active profile does not exist (yet), so they do
+		// not apply it.
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return nil
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	pathCmd := &cobra.Command{
+		Use:   "path",
+		Short: "Print the path of the configuration file",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configPath, pathErr := config.DefaultPath()
+			if pathErr != nil {
+				return pathErr
+			}
+			cmd.Println(configPath)
+			return nil
+		},
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the profiles in the configuration file",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profileName, _ := cmd.Flags().GetString("profile")
+			_, jjConfig, loadErr := loadConfig()
+			if loadErr != nil {
+				return loadErr
+			}
+
+			activeName, _, _ := jjConfig.Active(profileName)
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "\tPROFILE\tCHAIN\tCHAIN ID\tCONTRACT")
+			for _, name := range jjConfig.ProfileNames() {
+				profile := jjConfig.Profiles[name]
+				marker := ""
+				if name == activeName {
+					marker = "*"
+				}
+				chainID, _ := profile.Get("chain_id")
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", marker, name, profile.Chain, chainID, profile.Contract)
+			}
+			return w.Flush()
+		},
+	}
+
+	showCmd := &cobra.Command{
+		Use:   "show [<profile>]",
+		Short: "Show the settings of a profile (the active profile by default)",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profileName, _ := cmd.Flags().GetString("profile")
+			if len(args) > 0 {
+				profileName = args[0]
+			}
+
+			_, jjConfig, loadErr := loadConfig()
+			if loadErr != nil {
+				return loadErr
+			}
+
+			activeName, profile, profileErr := jjConfig.Active(profileName)
+			if profileErr != nil {
+				return profileErr
+			} else if activeName == "" {
+				return errors.New("no profile selected and no default profile configured")
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+			fmt.Fprintf(w, "profile\t%s\n", activeName)
+			for _, key := range config.Keys {
+				value, _ := profile.Get(key)
+				if value != "" {
+					fmt.Fprintf(w, "%s\t%s\n", key, value)
+				}
+			}
+			return w.Flush()
+		},
+	}
+
+	useCmd := &cobra.Command{
+		Use:   "use <profile>",
+		Short: "Set the default profile",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configPath, jjConfig, loadErr := loadConfig()
+			if loadErr != nil {
+				return loadErr
+			}
+
+			if _, ok := jjConfig.Profiles[args[0]]; !ok {
+				return config.ProfileNotFoundError{Name: args[0]}
+			}
+			jjConfig.Default = args[0]
+
+			return config.Save(configPath, jjConfig)
+		},
+	}
+
+	setCmd := &cobra.Command{
+		Use:   "set <profile> <key> <value> [<key> <value> ...]",
+		Short: "Set keys in a profile, creating the profile if it does not exist",
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 3 || len(args)%2 != 1 {
+				return errors.New("expected a profile name followed by pairs of keys and values")
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configPath, jjConfig, loadErr := loadConfig()
+			if loadErr != nil {
+				return loadErr
+			}
+
+			profile := jjConfig.Profiles[args[0]]
+			for i := 1; i < len(args); i += 2 {
+				setErr := profile.Set(args[i], args[i+1])
+				if setErr != nil {
+					return setErr
+				}
+			}
+
+			if jjConfig.Profiles == nil {
+				jjConfig.Profiles = map[string]config.Profile{}
+			}
+			jjConfig.Profiles[args[0]] = profile
+			// The first profile becomes the default.
+			if jjConfig.Default == "" {
+				jjConfig.Default = args[0]
+			}
+
+			return config.Save(configPath, jjConfig)
+		},
+	}
+
+	unsetCmd := &cobra.Command{
+		Use:   "unset <profile> <key> [<key> ...]",
+		Short: "Remove keys from a profile",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configPath, jjConfig, loadErr := loadConfig()
+			if loadErr != nil {
+				return loadErr
+			}
+
+			profile, ok := jjConfig.Profiles[args[0]]
+			if !ok {
+				return config.ProfileNotFoundError{Name: args[0]}
+			}
+			for _, key := range args[1:] {
+				setErr := profile.Set(key, "")
+				if setErr != nil {
+					return setErr
+				}
+			}
+			jjConfig.Profiles[args[0]] = profile
+
+			return config.Save(configPath, jjConfig)
+		},
+	}
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete <profile>",
+		Short: "Delete a profile",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configPath, jjConfig, loadErr := loadConfig()
+			if loadErr != nil {
+				return loadErr
+			}
+
+			if _, ok := jjConfig.Profiles[args[0]]; !ok {
+				return config.ProfileNotFoundError{Name: args[0]}
+			}
+			delete(jjConfig.Profiles, args[0])
+			if jjConfig.Default == args[0] {
+				jjConfig.Default = ""
+			}
+
+			return config.Save(configPath, jjConfig)
+		},
+	}
+
+	configCmd.AddCommand(pathCmd, listCmd, showCmd, useCmd, setCmd, unsetCmd, deleteCmd)
+
+	return configCmd
+}
+
+// Loads the jj configuration file, returning its path along with its contents.
+func loadConfig() (string, config.Config, error) {
+	configPath, pathErr := config.DefaultPath()
+	if pathErr != nil {
+		return "", config.Config{}, pathErr
+	}
+	jjConfig, loadErr := config.Load(configPath)
+	return configPath, jjConfig, loadErr
+}
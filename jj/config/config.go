@@ -0,0 +1,283 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/yaml.v3"
+)
+
+// If this environment variable is set, it is used as the path to the configuration file instead of
+// DefaultPath.
+const ConfigEnvVar string = "JJ_CONFIG"
+
+// If this environment variable is set, it selects the active profile (unless a profile is passed with
+// --profile).
+const ProfileEnvVar string = "JJ_PROFILE"
+
+var ErrNoConfigDir error = errors.New("could not determine the user configuration directory -- please set the JJ_CONFIG environment variable")
+var ErrUnknownKey error = fmt.Errorf("unknown profile key: must be one of %s", strings.Join(Keys, ", "))
+
+// ProfileNotFoundError is returned when a profile which does not exist in the configuration is requested.
+type ProfileNotFoundError struct {
+	Name string
+}
+
+func (e ProfileNotFoundError) Error() string {
+	return fmt.Sprintf("profile %s not found in the jj configuration", e.Name)
+}
+
+// Profile holds the default settings for a single chain and deployment of the JackpotJunction contract.
+// Gas settings are in wei, like the corresponding flags on the generated commands.
+type Profile struct {
+	Chain                string `yaml:"chain,omitempty"`
+	ChainID              uint64 `yaml:"chain_id,omitempty"`
+	RPC                  string `yaml:"rpc,omitempty"`
+	Contract             string `yaml:"contract,omitempty"`
+	Keyfile              string `yaml:"keyfile,omitempty"`
+	Signer               string `yaml:"signer,omitempty"`
+	GasLimit             uint64 `yaml:"gas_limit,omitempty"`
+	GasPrice             string `yaml:"gas_price,omitempty"`
+	MaxFeePerGas         string `yaml:"max_fee_per_gas,omitempty"`
+	MaxPriorityFeePerGas string `yaml:"max_priority_fee_per_gas,omitempty"`
+	Timeout              uint   `yaml:"timeout,omitempty"`
+}
+
+// Keys are the names of the settings in a profile, as used in the configuration file and by Set.
+var Keys []string = []string{"chain", "chain_id", "rpc", "contract", "keyfile", "signer", "gas_limit", "gas_price", "max_fee_per_gas", "max_priority_fee_per_gas", "timeout"}
+
+// Config is the format of the jj configuration file:
+//
+//	default: sepolia
+//	profiles:
+//	  sepolia:
+//	    chain: sepolia
+//	    chain_id: 11155111
+//	    rpc: https://rpc.sepolia.org
+//	    contract: "0x..."
+//	    keyfile: ~/.ethereum/keystore/UTC--...
+//	    max_priority_fee_per_gas: "1000000000"
+type Config struct {
+	Default  string             `yaml:"default,omitempty"`
+	Profiles map[string]Profile `yaml:"profiles,omitempty"`
+}
+
+// DefaultPath returns the path of the configuration file: the value of JJ_CONFIG if it is set, and
+// config.yaml in the jj directory under the user configuration directory (e.g. ~/.config/jj/config.yaml)
+// otherwise.
+func DefaultPath() (string, error) {
+	if path := os.Getenv(ConfigEnvVar); path != "" {
+		return path, nil
+	}
+
+	configDir, configDirErr := os.UserConfigDir()
+	if configDirErr != nil {
+		return "", ErrNoConfigDir
+	}
+	return filepath.Join(configDir, "jj", "config.yaml"), nil
+}
+
+// Load reads the configuration file at the given path. If the file does not exist, it returns an empty
+// configuration.
+func Load(path string) (Config, error) {
+	var config Config
+
+	contents, readErr := os.ReadFile(path)
+	if errors.Is(readErr, fs.ErrNotExist) {
+		return config, nil
+	} else if readErr != nil {
+		return config, readErr
+	}
+
+	unmarshalErr := yaml.Unmarshal(contents, &config)
+	if unmarshalErr != nil {
+		return config, fmt.Errorf("could not parse %s: %s", path, unmarshalErr.Error())
+	}
+	return config, nil
+}
+
+// Save writes the configuration to the given path, creating its directory if necessary. The file is only
+// readable by the current user, as it may contain RPC URLs with API keys.
+func Save(path string, config Config) error {
+	mkdirErr := os.MkdirAll(filepath.Dir(path), 0700)
+	if mkdirErr != nil {
+		return mkdirErr
+	}
+
+	contents, marshalErr := yaml.Marshal(config)
+	if marshalErr != nil {
+		return marshalErr
+	}
+
+	return os.WriteFile(path, contents, 0600)
+}
+
+// ProfileNames returns the names of the profiles in the configuration, in alphabetical order.
+func (config Config) ProfileNames() []string {
+	names := make([]string, 0, len(config.Profiles))
+	for name := range config.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Active returns the name and contents of the active profile. The active profile is the named profile if
+// name is not empty, the profile named by JJ_PROFILE if that is set, and the default profile otherwise. If
+// no profile is selected at all, Active returns an empty profile. It is an error to select a profile which
+// does not exist.
+func (config Config) Active(name string) (string, Profile, error) {
+	if name == "" {
+		name = os.Getenv(ProfileEnvVar)
+	}
+	if name == "" {
+		name = config.Default
+	}
+	if name == "" {
+		return "", Profile{}, nil
+	}
+
+	profile, ok := config.Profiles[name]
+	if !ok {
+		return name, profile, ProfileNotFoundError{Name: name}
+	}
+	return name, profile, nil
+}
+
+// Get returns the value of the given key in the profile as a string (empty if it is not set).
+func (profile Profile) Get(key string) (string, error) {
+	switch key {
+	case "chain":
+		return profile.Chain, nil
+	case "chain_id":
+		return formatUint(profile.ChainID), nil
+	case "rpc":
+		return profile.RPC, nil
+	case "contract":
+		return profile.Contract, nil
+	case "keyfile":
+		return profile.Keyfile, nil
+	case "signer":
+		return profile.Signer, nil
+	case "gas_limit":
+		return formatUint(profile.GasLimit), nil
+	case "gas_price":
+		return profile.GasPrice, nil
+	case "max_fee_per_gas":
+		return profile.MaxFeePerGas, nil
+	case "max_priority_fee_per_gas":
+		return profile.MaxPriorityFeePerGas, nil
+	case "timeout":
+		return formatUint(uint64(profile.Timeout)), nil
+	}
+	return "", ErrUnknownKey
+}
+
+// Set validates the given value and sets the given key in the profile to it. An empty value unsets the key.
+func (profile *Profile) Set(key, value string) error {
+	switch key {
+	case "chain":
+		profile.Chain = value
+	case "chain_id":
+		return parseUint(key, value, 64, &profile.ChainID)
+	case "rpc":
+		profile.RPC = value
+	case "contract":
+		if value != "" && !common.IsHexAddress(value) {
+			return errors.New("contract is not a valid Ethereum address")
+		}
+		profile.Contract = value
+	case "keyfile":
+		profile.Keyfile = value
+	case "signer":
+		profile.Signer = value
+	case "gas_limit":
+		return parseUint(key, value, 64, &profile.GasLimit)
+	case "gas_price":
+		return parseWei(key, value, &profile.GasPrice)
+	case "max_fee_per_gas":
+		return parseWei(key, value, &profile.MaxFeePerGas)
+	case "max_priority_fee_per_gas":
+		return parseWei(key, value, &profile.MaxPriorityFeePerGas)
+	case "timeout":
+		var timeout uint64
+		parseErr := parseUint(key, value, 32, &timeout)
+		profile.Timeout = uint(timeout)
+		return parseErr
+	default:
+		return ErrUnknownKey
+	}
+	return nil
+}
+
+// FlagDefaults maps the names of command line flags to the values that the profile provides for them.
+// Settings which are not set in the profile are omitted. The keyfile path may start with ~, which is
+// expanded to the home directory of the current user.
+func (profile Profile) FlagDefaults() map[string]string {
+	defaults := map[string]string{}
+	setDefault := func(flagName, value string) {
+		if value != "" {
+			defaults[flagName] = value
+		}
+	}
+
+	setDefault("rpc", profile.RPC)
+	setDefault("contract", profile.Contract)
+	setDefault("keyfile", expandHome(profile.Keyfile))
+	setDefault("signer", profile.Signer)
+	setDefault("gas-limit", formatUint(profile.GasLimit))
+	setDefault("gas-price", profile.GasPrice)
+	setDefault("max-fee-per-gas", profile.MaxFeePerGas)
+	setDefault("max-priority-fee-per-gas", profile.MaxPriorityFeePerGas)
+	setDefault("timeout", formatUint(uint64(profile.Timeout)))
+
+	return defaults
+}
+
+func formatUint(value uint64) string {
+	if value == 0 {
+		return ""
+	}
+	return strconv.FormatUint(value, 10)
+}
+
+func parseUint(key, value string, bitSize int, target *uint64) error {
+	if value == "" {
+		*target = 0
+		return nil
+	}
+	parsed, parseErr := strconv.ParseUint(value, 10, bitSize)
+	if parseErr != nil {
+		return fmt.Errorf("%s must be a non-negative integer", key)
+	}
+	*target = parsed
+	return nil
+}
+
+func parseWei(key, value string, target *string) error {
+	if value != "" {
+		if _, parseErr := strconv.ParseUint(value, 10, 64); parseErr != nil {
+			return fmt.Errorf("%s must be a non-negative integer amount of wei", key)
+		}
+	}
+	*target = value
+	return nil
+}
+
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, homeErr := os.UserHomeDir()
+	if homeErr != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
@@ -0,0 +1,196 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestActive(t *testing.T) {
+	jjConfig := Config{
+		Default: "sepolia",
+		Profiles: map[string]Profile{
+			"sepolia":  {Chain: "sepolia"},
+			"arbitrum": {Chain: "arbitrum"},
+			"local":    {Chain: "local"},
+		},
+	}
+
+	cases := []struct {
+		name     string
+		config   Config
+		flag     string
+		env      string
+		expected string
+		err      bool
+	}{
+		{"default profile", jjConfig, "", "", "sepolia", false},
+		{"environment beats default", jjConfig, "", "arbitrum", "arbitrum", false},
+		{"flag beats environment", jjConfig, "local", "arbitrum", "local", false},
+		{"flag beats default", jjConfig, "local", "", "local", false},
+		{"no profile selected", Config{Profiles: jjConfig.Profiles}, "", "", "", false},
+		{"missing profile from flag", jjConfig, "mainnet", "", "mainnet", true},
+		{"missing profile from environment", jjConfig, "", "mainnet", "mainnet", true},
+		{"missing default profile", Config{Default: "mainnet"}, "", "", "mainnet", true},
+	}
+
+	for _, c := range cases {
+		t.Setenv(ProfileEnvVar, c.env)
+		name, profile, activeErr := c.config.Active(c.flag)
+		if name != c.expected {
+			t.Errorf("%s: expected profile %q, got %q", c.name, c.expected, name)
+		}
+		if c.err {
+			var notFound ProfileNotFoundError
+			if !errors.As(activeErr, &notFound) || notFound.Name != c.expected {
+				t.Errorf("%s: expected ProfileNotFoundError for %s, got %v", c.name, c.expected, activeErr)
+			}
+			continue
+		}
+		if activeErr != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, activeErr.Error())
+		} else if profile.Chain != c.expected {
+			t.Errorf("%s: expected the %q profile, got %+v", c.name, c.expected, profile)
+		}
+	}
+}
+
+func TestSetAndGet(t *testing.T) {
+	cases := []struct {
+		key   string
+		value string
+		valid bool
+	}{
+		{"chain", "sepolia", true},
+		{"chain_id", "11155111", true},
+		{"chain_id", "-1", false},
+		{"chain_id", "sepolia", false},
+		{"rpc", "https://rpc.sepolia.org", true},
+		{"contract", "0x0000000000000000000000000000000000000abc", true},
+		{"contract", "0xabc", false},
+		{"keyfile", "~/keystore", true},
+		{"signer", "env:JJ_KEY", true},
+		{"gas_limit", "300000", true},
+		{"gas_limit", "1.5", false},
+		{"gas_price", "1000000000", true},
+		{"gas_price", "1 gwei", false},
+		{"max_fee_per_gas", "2000000000", true},
+		{"max_fee_per_gas", "-2", false},
+		{"max_priority_fee_per_gas", "1000000000", true},
+		{"max_priority_fee_per_gas", "0x10", false},
+		{"timeout", "60", true},
+		{"timeout", "4294967296", false},
+		{"colour", "blue", false},
+	}
+
+	for _, c := range cases {
+		var profile Profile
+		setErr := profile.Set(c.key, c.value)
+		if (setErr == nil) != c.valid {
+			t.Errorf("%s=%s: expected valid %t, got error %v", c.key, c.value, c.valid, setErr)
+			continue
+		}
+		if !c.valid {
+			continue
+		}
+
+		value, getErr := profile.Get(c.key)
+		if getErr != nil {
+			t.Errorf("%s: unexpected error: %s", c.key, getErr.Error())
+		} else if value != c.value {
+			t.Errorf("%s: expected %q, got %q", c.key, c.value, value)
+		}
+
+		// An empty value unsets the key.
+		profile.Set(c.key, "")
+		if value, _ := profile.Get(c.key); value != "" {
+			t.Errorf("%s: expected the key to be unset, got %q", c.key, value)
+		}
+	}
+
+	if _, getErr := (Profile{}).Get("colour"); !errors.Is(getErr, ErrUnknownKey) {
+		t.Errorf("expected ErrUnknownKey, got %v", getErr)
+	}
+
+	// Every key must be supported by both Get and Set.
+	for _, key := range Keys {
+		var profile Profile
+		if setErr := profile.Set(key, ""); setErr != nil {
+			t.Errorf("%s: Set does not support the key: %s", key, setErr.Error())
+		}
+		if _, getErr := profile.Get(key); getErr != nil {
+			t.Errorf("%s: Get does not support the key: %s", key, getErr.Error())
+		}
+	}
+}
+
+func TestFlagDefaults(t *testing.T) {
+	home, homeErr := os.UserHomeDir()
+	if homeErr != nil {
+		t.Skipf("no home directory: %s", homeErr.Error())
+	}
+
+	profile := Profile{
+		Chain:                "sepolia",
+		ChainID:              11155111,
+		RPC:                  "https://rpc.sepolia.org",
+		Keyfile:              "~/keystore/key.json",
+		GasLimit:             300000,
+		MaxPriorityFeePerGas: "1000000000",
+	}
+	expected := map[string]string{
+		"rpc":                      "https://rpc.sepolia.org",
+		"keyfile":                  filepath.Join(home, "keystore", "key.json"),
+		"gas-limit":                "300000",
+		"max-priority-fee-per-gas": "1000000000",
+	}
+
+	defaults := profile.FlagDefaults()
+	if len(defaults) != len(expected) {
+		t.Errorf("expected flag defaults %v, got %v", expected, defaults)
+	}
+	for flagName, value := range expected {
+		if defaults[flagName] != value {
+			t.Errorf("%s: expected %q, got %q", flagName, value, defaults[flagName])
+		}
+	}
+
+	if defaults := (Profile{Keyfile: "/etc/~/key.json"}).FlagDefaults(); defaults["keyfile"] != "/etc/~/key.json" {
+		t.Errorf("expected only a leading ~ to be expanded, got %q", defaults["keyfile"])
+	}
+}
+
+func TestSaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jj", "config.yaml")
+
+	empty, loadErr := Load(path)
+	if loadErr != nil || empty.Default != "" || len(empty.Profiles) != 0 {
+		t.Fatalf("expected an empty configuration from a missing file, got %+v and %v", empty, loadErr)
+	}
+
+	jjConfig := Config{Default: "sepolia", Profiles: map[string]Profile{"sepolia": {ChainID: 11155111, Timeout: 60}}}
+	if saveErr := Save(path, jjConfig); saveErr != nil {
+		t.Fatalf("unexpected error: %s", saveErr.Error())
+	}
+	info, statErr := os.Stat(path)
+	if statErr != nil {
+		t.Fatalf("unexpected error: %s", statErr.Error())
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("expected the configuration file to have mode 0600, got %s", info.Mode().Perm())
+	}
+
+	loaded, loadErr := Load(path)
+	if loadErr != nil {
+		t.Fatalf("unexpected error: %s", loadErr.Error())
+	}
+	if loaded.Default != "sepolia" || loaded.Profiles["sepolia"] != jjConfig.Profiles["sepolia"] {
+		t.Errorf("expected %+v, got %+v", jjConfig, loaded)
+	}
+
+	t.Setenv(ConfigEnvVar, path)
+	if defaultPath, _ := DefaultPath(); defaultPath != path {
+		t.Errorf("expected JJ_CONFIG to set the path to %s, got %s", path, defaultPath)
+	}
+}
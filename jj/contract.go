@@ -0,0 +1,701 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction"
+	"github.com/moonstream-to/degen-trail/jj/items"
+)
+
+// Maps the names of the generated JackpotJunction commands to the names of their flags which take pool IDs.
+var poolIDFlags map[string][]string = map[string][]string{
+	"balance-of":               {"id"},
+	"balance-of-batch":         {"ids"},
+	"burn":                     {"pool-id"},
+	"burn-batch":               {"pool-i-ds"},
+	"craft":                    {"pool-id"},
+	"equip":                    {"pool-i-ds"},
+	"genera":                   {"pool-id"},
+	"pool-metadata":            {"pool-id"},
+	"safe-batch-transfer-from": {"ids"},
+	"safe-transfer-from":       {"id"},
+	"uri":                      {"pool-id"},
+}
+
+// DecorateContractCommand adds jj specific behavior to the commands generated for the JackpotJunction
+// contract. The generated bindings are not edited by hand, so any such behavior is added here instead.
+func DecorateContractCommand(contractCmd *cobra.Command) {
+	// The ABI is embedded in the bindings, so this cannot fail.
+	contractABI, _ := JackpotJunction.JackpotJunctionMetaData.GetAbi()
+
+	for _, cmd := range contractCmd.Commands() {
+		if cmd.GroupID == "transact" {
+			hooks := []SendHook{WaitForTransaction(cmd)}
+			if cmd.Name() == "accept" {
+				hooks = append(hooks, GuardAccept(cmd))
+			}
+			TransactWithSigner(cmd, contractABI, hooks...)
+		}
+
+		flagNames, ok := poolIDFlags[cmd.Name()]
+		if ok {
+			AcceptItemNames(cmd, flagNames...)
+		}
+	}
+}
+
+// DecodeContractErrors wraps the RunE of cmd and of all its descendants so that errors which carry revert
+// data from the JackpotJunction contract are replaced with a readable explanation of the failure.
+func DecodeContractErrors(cmd *cobra.Command) {
+	if cmd.RunE != nil {
+		runE := cmd.RunE
+		cmd.RunE = func(cmd *cobra.Command, args []string) error {
+			return JackpotJunction.DecodeError(runE(cmd, args))
+		}
+	}
+
+	for _, subcommand := range cmd.Commands() {
+		DecodeContractErrors(subcommand)
+	}
+}
+
+// AcceptItemNames makes the given flags on cmd accept item names (e.g. t3-forest-wheels) in addition to
+// numeric pool IDs. Flags whose values are JSON arrays (or @files containing JSON arrays) may mix the two.
+// The item names are translated into pool IDs before the command's own PreRunE is executed.
+func AcceptItemNames(cmd *cobra.Command, flagNames ...string) {
+	for _, flagName := range flagNames {
+		flag := cmd.Flags().Lookup(flagName)
+		if flag != nil {
+			flag.Usage = fmt.Sprintf("%s (pool IDs or item names, e.g. t3-forest-wheels)", flag.Usage)
+		}
+	}
+
+	preRunE := cmd.PreRunE
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		for _, flagName := range flagNames {
+			flag := cmd.Flags().Lookup(flagName)
+			if flag == nil || !flag.Changed {
+				continue
+			}
+
+			translated, translateErr := translatePoolIDs(flag.Value.String())
+			if translateErr != nil {
+				return fmt.Errorf("--%s: %s", flagName, translateErr.Error())
+			}
+
+			setErr := cmd.Flags().Set(flagName, translated)
+			if setErr != nil {
+				return setErr
+			}
+		}
+
+		if preRunE != nil {
+			return preRunE(cmd, args)
+		}
+		return nil
+	}
+}
+
+// Translates a raw flag value representing either a single pool ID or a JSON array of pool IDs into the
+// numeric form expected by the generated commands. Numeric pool IDs are passed through unchanged, and only
+// item names (and poolMetadata objects, in JSON arrays) are translated.
+func translatePoolIDs(raw string) (string, error) {
+	if strings.HasPrefix(raw, "@") {
+		contents, readErr := os.ReadFile(strings.TrimPrefix(raw, "@"))
+		if readErr != nil {
+			return "", readErr
+		}
+		raw = string(contents)
+	}
+
+	raw = strings.TrimSpace(raw)
+	if !strings.HasPrefix(raw, "[") {
+		poolID, parseErr := items.ParsePoolID(raw)
+		if parseErr != nil {
+			return "", parseErr
+		}
+		return poolID.String(), nil
+	}
+
+	var elements []json.RawMessage
+	unmarshalErr := json.Unmarshal([]byte(raw), &elements)
+	if unmarshalErr != nil {
+		return "", unmarshalErr
+	}
+
+	poolIDs := make([]*big.Int, len(elements))
+	for i, element := range elements {
+		var name string
+		if json.Unmarshal(element, &name) == nil {
+			poolID, parseErr := items.ParsePoolID(name)
+			if parseErr != nil {
+				return "", parseErr
+			}
+			poolIDs[i] = poolID
+			continue
+		}
+
+		if poolID, ok := new(big.Int).SetString(string(element), 10); ok {
+			poolIDs[i] = poolID
+			continue
+		}
+
+		var item items.Item
+		itemErr := json.Unmarshal(element, &item)
+		if itemErr != nil {
+			return "", itemErr
+		}
+		poolIDs[i] = item.Big()
+	}
+
+	translated, marshalErr := json.Marshal(poolIDs)
+	return string(translated), marshalErr
+}
+
+// A SendHook runs in a transaction command (see TransactWithSigner) once the sender of the transaction is
+// known. It may prevent the transaction from being sent by returning an error. If it returns a non-nil
+// function, that function is called with the transaction after it has been sent (but not with --simulate).
+type SendHook func(cmd *cobra.Command, client *ethclient.Client, contractAddress common.Address, transactionOpts *bind.TransactOpts) (func(transaction *types.Transaction) error, error)
+
+// Usage of the --signer flag, shared by all commands which submit transactions.
+const signerFlagUsage string = "Signer to use instead of --keyfile: keystore:<path>, key-env:<variable>, key-file:<path>, mnemonic-env:<variable>[#<derivation path>], mnemonic-file:<path>[#<derivation path>] or external:<url>#<address> (--password unlocks keystores and is the mnemonic passphrase)"
+
+// TransactWithSigner replaces the implementation of a generated transaction command so that:
+//  1. The transaction is signed by a JackpotJunction.Signer. The command gets a --signer flag, and --keyfile
+//     is equivalent to --signer keystore:<keyfile>.
+//  2. Unless fees are passed on the command line, they are estimated from the recent fee history of the chain
+//     (see JackpotJunction.EstimateFees) and lowered, if necessary, so that the transaction costs at most
+//     --max-spend.
+//
+// The command parses the method arguments from its flags according to the contract ABI, like the generated
+// implementation does, and produces the same output. With --simulate, it also reports the fees it would pay.
+// The hooks are run, in order, before the transaction is built.
+//
+// The generated commands load their keys from keystore files and build their transaction options in the same
+// function that sends the transaction, so their implementation cannot be reused with other signers. If cmd
+// does not correspond to a method on the contract, or its flags do not match the inputs of that method, the
+// command fails before anything is sent. TestTransactionsMatchGenerated checks that every decorated command
+// signs the same transaction as its generated implementation, so that changes to the generated commands which
+// this parsing does not follow are caught when the bindings are regenerated.
+func TransactWithSigner(cmd *cobra.Command, contractABI *abi.ABI, hooks ...SendHook) {
+	method, methodErr := transactMethod(contractABI, cmd.Name())
+	var argumentFlags []*pflag.Flag
+	if methodErr == nil {
+		argumentFlags, methodErr = methodArgumentFlags(cmd, method)
+	}
+
+	var signerSpec, maxSpendRaw string
+	var tipPercentile float64
+	var feeHistoryBlocks uint64
+	var contractAddress common.Address
+	var maxSpend *big.Int
+	var arguments []interface{}
+
+	cmd.Flags().StringVar(&signerSpec, "signer", "", signerFlagUsage)
+	cmd.Flags().StringVar(&maxSpendRaw, "max-spend", "", "Maximum amount (in wei) that the transaction may cost, including its value -- estimated fees are lowered to fit")
+	cmd.Flags().Float64Var(&tipPercentile, "tip-percentile", JackpotJunction.DefaultTipPercentile, "Percentile of the priority fees paid in recent blocks to use as the priority fee, when fees are estimated")
+	cmd.Flags().Uint64Var(&feeHistoryBlocks, "fee-history-blocks", JackpotJunction.DefaultFeeHistoryBlocks, "Number of recent blocks to estimate fees from")
+
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if methodErr != nil {
+			return methodErr
+		}
+
+		keyfile, _ := cmd.Flags().GetString("keyfile")
+		if cmd.Flags().Changed("keyfile") && cmd.Flags().Changed("signer") {
+			return errors.New("only one of --keyfile and --signer may be specified")
+		} else if cmd.Flags().Changed("keyfile") || (signerSpec == "" && keyfile != "") {
+			signerSpec = "keystore:" + keyfile
+		} else if signerSpec == "" {
+			return errors.New("one of --keyfile or --signer is required")
+		}
+
+		contractAddressRaw, _ := cmd.Flags().GetString("contract")
+		if contractAddressRaw == "" {
+			return fmt.Errorf("--contract not specified")
+		} else if !common.IsHexAddress(contractAddressRaw) {
+			return fmt.Errorf("--contract is not a valid Ethereum address")
+		}
+		contractAddress = common.HexToAddress(contractAddressRaw)
+
+		if maxSpendRaw != "" {
+			var ok bool
+			maxSpend, ok = new(big.Int).SetString(maxSpendRaw, 0)
+			if !ok || maxSpend.Sign() < 0 {
+				return errors.New("--max-spend must be a non-negative integer amount of wei")
+			}
+		}
+
+		arguments = make([]interface{}, len(method.Inputs))
+		for i, input := range method.Inputs {
+			var parseErr error
+			arguments[i], parseErr = parseArgument(argumentFlags[i], input.Type)
+			if parseErr != nil {
+				return parseErr
+			}
+		}
+
+		return nil
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		flags := cmd.Flags()
+		rpc, _ := flags.GetString("rpc")
+		password, _ := flags.GetString("password")
+		nonce, _ := flags.GetString("nonce")
+		value, _ := flags.GetString("value")
+		gasPrice, _ := flags.GetString("gas-price")
+		maxFeePerGas, _ := flags.GetString("max-fee-per-gas")
+		maxPriorityFeePerGas, _ := flags.GetString("max-priority-fee-per-gas")
+		gasLimit, _ := flags.GetUint64("gas-limit")
+		simulate, _ := flags.GetBool("simulate")
+		timeout, _ := flags.GetUint("timeout")
+
+		client, clientErr := JackpotJunction.NewClient(rpc)
+		if clientErr != nil {
+			return clientErr
+		}
+
+		signer, signerErr := JackpotJunction.ParseSigner(cmd.Context(), signerSpec, password)
+		if signerErr != nil {
+			return signerErr
+		}
+		if closer, ok := signer.(interface{ Close() }); ok {
+			defer closer.Close()
+		}
+
+		chainIDCtx, cancelChainIDCtx := JackpotJunction.NewChainContext(timeout)
+		defer cancelChainIDCtx()
+		chainID, chainIDErr := client.ChainID(chainIDCtx)
+		if chainIDErr != nil {
+			return chainIDErr
+		}
+
+		transactionOpts := JackpotJunction.NewSignerTransactOpts(cmd.Context(), signer, chainID)
+		JackpotJunction.SetTransactionParametersFromArgs(transactionOpts, nonce, value, gasPrice, maxFeePerGas, maxPriorityFeePerGas, gasLimit, simulate)
+
+		var afterSend []func(transaction *types.Transaction) error
+		for _, hook := range hooks {
+			after, hookErr := hook(cmd, client, contractAddress, transactionOpts)
+			if hookErr != nil {
+				return hookErr
+			}
+			if after != nil {
+				afterSend = append(afterSend, after)
+			}
+		}
+
+		data, packErr := packCalldata(contractABI, method, arguments)
+		if packErr != nil {
+			return packErr
+		}
+
+		// The gas limit is needed to bound the cost of the transaction, so we estimate it here instead of
+		// leaving it to the bind package.
+		if transactionOpts.GasLimit == 0 {
+			gasEstimationCtx, cancelGasEstimationCtx := JackpotJunction.NewChainContext(timeout)
+			defer cancelGasEstimationCtx()
+			gasEstimate, gasEstimateErr := client.EstimateGas(gasEstimationCtx, ethereum.CallMsg{
+				From:  transactionOpts.From,
+				To:    &contractAddress,
+				Value: transactionOpts.Value,
+				Data:  data,
+			})
+			if gasEstimateErr != nil {
+				return gasEstimateErr
+			}
+			transactionOpts.GasLimit = gasEstimate
+		}
+
+		var fees JackpotJunction.FeeEstimate
+		if gasPrice == "" && maxFeePerGas == "" && maxPriorityFeePerGas == "" {
+			feesCtx, cancelFeesCtx := JackpotJunction.NewChainContext(timeout)
+			defer cancelFeesCtx()
+			var feesErr error
+			fees, feesErr = JackpotJunction.EstimateFees(feesCtx, client, JackpotJunction.FeeOptions{HistoryBlocks: feeHistoryBlocks, TipPercentile: tipPercentile})
+			if feesErr != nil {
+				return feesErr
+			}
+
+			fees, feesErr = fees.Cap(transactionOpts.GasLimit, transactionOpts.Value, maxSpend)
+			if feesErr != nil {
+				return feesErr
+			}
+			fees.Apply(transactionOpts)
+		} else if maxSpend != nil {
+			// Fees which are passed by hand are never lowered, but they must respect --max-spend.
+			fees = JackpotJunction.FeeEstimate{Legacy: transactionOpts.GasPrice != nil, GasPrice: transactionOpts.GasPrice, MaxFeePerGas: transactionOpts.GasFeeCap}
+			if fees.FeeCap() == nil {
+				return errors.New("--max-fee-per-gas is required to respect --max-spend when --max-priority-fee-per-gas is set")
+			}
+			if cost := fees.MaxCost(transactionOpts.GasLimit, transactionOpts.Value); cost.Cmp(maxSpend) > 0 {
+				return JackpotJunction.MaxSpendExceededError{MaxSpend: maxSpend, MinCost: cost}
+			}
+		}
+
+		contract := bind.NewBoundContract(contractAddress, *contractABI, client, client, client)
+		transaction, transactionErr := contract.RawTransact(transactionOpts, data)
+		if transactionErr != nil {
+			return transactionErr
+		}
+
+		cmd.Printf("Transaction hash: %s\n", transaction.Hash().Hex())
+		if !transactionOpts.NoSend {
+			cmd.Println("Transaction submitted")
+			for _, after := range afterSend {
+				afterErr := after(transaction)
+				if afterErr != nil {
+					return afterErr
+				}
+			}
+			return nil
+		}
+
+		transactionBinary, transactionBinaryErr := transaction.MarshalBinary()
+		if transactionBinaryErr != nil {
+			return transactionBinaryErr
+		}
+		cmd.Printf("Transaction: %s\nEstimated gas: %d\n", hex.EncodeToString(transactionBinary), transaction.Gas())
+		if fees.BaseFee != nil || fees.Legacy {
+			cmd.Printf("Fees: %s\n", fees.String())
+		}
+		maxCost := new(big.Int).Add(new(big.Int).Mul(transaction.GasFeeCap(), new(big.Int).SetUint64(transaction.Gas())), transaction.Value())
+		cmd.Printf("Maximum cost: %s ETH\n", JackpotJunction.FormatEther(maxCost))
+
+		return nil
+	}
+}
+
+var ErrUnknownTransactMethod error = errors.New("command does not correspond to a method on the contract")
+
+// Returns the method of the contract which the generated transaction command with the given name calls. The
+// receive and fallback functions are not among the methods of the ABI, so they are matched explicitly.
+func transactMethod(contractABI *abi.ABI, commandName string) (abi.Method, error) {
+	switch {
+	case commandName == "receive" && contractABI.HasReceive():
+		return contractABI.Receive, nil
+	case commandName == "fallback" && contractABI.HasFallback():
+		return contractABI.Fallback, nil
+	}
+
+	for _, candidate := range contractABI.Methods {
+		if strings.ReplaceAll(commandName, "-", "") == strings.ToLower(candidate.Name) {
+			return candidate, nil
+		}
+	}
+	return abi.Method{}, fmt.Errorf("%w: %s", ErrUnknownTransactMethod, commandName)
+}
+
+// Packs the calldata for a call to method. The receive and fallback functions are called with empty calldata.
+func packCalldata(contractABI *abi.ABI, method abi.Method, arguments []interface{}) ([]byte, error) {
+	if method.Type == abi.Receive || method.Type == abi.Fallback {
+		return nil, nil
+	}
+	return contractABI.Pack(method.Name, arguments...)
+}
+
+// Returns the flags which hold the method arguments of a generated command. The generated commands define
+// these flags, in the order of the method inputs, right after the --contract flag, and start the usage of each
+// of them with "<flag name> argument". Returns an error if the flags of cmd do not follow that layout, so that a change
+// in the generated commands is caught instead of passing the wrong values to the method.
+func methodArgumentFlags(cmd *cobra.Command, method abi.Method) ([]*pflag.Flag, error) {
+	flags := cmd.Flags()
+	sortFlags := flags.SortFlags
+	flags.SortFlags = false
+	var ordered []*pflag.Flag
+	flags.VisitAll(func(flag *pflag.Flag) {
+		ordered = append(ordered, flag)
+	})
+	flags.SortFlags = sortFlags
+
+	numArguments := len(method.Inputs)
+	for i, flag := range ordered {
+		if flag.Name != "contract" {
+			continue
+		}
+		if i+1+numArguments > len(ordered) {
+			break
+		}
+
+		argumentFlags := ordered[i+1 : i+1+numArguments]
+		for _, argumentFlag := range argumentFlags {
+			if !strings.HasPrefix(argumentFlag.Usage, argumentFlag.Name+" argument") {
+				return nil, fmt.Errorf("%s: --%s is not an argument of %s", cmd.Name(), argumentFlag.Name, method.Sig)
+			}
+		}
+		return argumentFlags, nil
+	}
+	return nil, fmt.Errorf("%s: could not find the %d arguments of %s among the flags", cmd.Name(), numArguments, method.Sig)
+}
+
+// Parses the value of a method argument flag in the same way as the generated commands.
+func parseArgument(flag *pflag.Flag, argumentType abi.Type) (interface{}, error) {
+	raw := flag.Value.String()
+
+	switch argumentType.T {
+	case abi.AddressTy:
+		if raw == "" {
+			return nil, fmt.Errorf("--%s argument not specified", flag.Name)
+		} else if !common.IsHexAddress(raw) {
+			return nil, fmt.Errorf("--%s argument is not a valid Ethereum address", flag.Name)
+		}
+		return common.HexToAddress(raw), nil
+	case abi.UintTy, abi.IntTy:
+		if raw == "" {
+			return nil, fmt.Errorf("--%s argument not specified", flag.Name)
+		}
+		parsed, ok := new(big.Int).SetString(raw, 0)
+		if !ok {
+			return nil, fmt.Errorf("--%s argument is not a valid integer", flag.Name)
+		}
+		return parsed, nil
+	case abi.BoolTy:
+		switch strings.ToLower(raw) {
+		case "true", "t", "y", "yes", "1":
+			return true, nil
+		case "false", "f", "n", "no", "0":
+			return false, nil
+		}
+		return nil, fmt.Errorf("--%s argument must be a boolean", flag.Name)
+	case abi.BytesTy:
+		return hex.DecodeString(strings.TrimPrefix(raw, "0x"))
+	case abi.StringTy:
+		return raw, nil
+	}
+
+	// Slices, and any other types (arrays, tuples, fixed size byte arrays), are passed as JSON, either directly
+	// or in an @file.
+	if raw == "" {
+		return nil, fmt.Errorf("--%s argument not specified", flag.Name)
+	} else if strings.HasPrefix(raw, "@") {
+		contents, readErr := os.ReadFile(strings.TrimPrefix(raw, "@"))
+		if readErr != nil {
+			return nil, readErr
+		}
+		raw = string(contents)
+	}
+	parsed := reflect.New(argumentType.GetType())
+	unmarshalErr := json.Unmarshal([]byte(raw), parsed.Interface())
+	if unmarshalErr != nil {
+		return nil, fmt.Errorf("--%s argument is not valid JSON for %s: %s", flag.Name, argumentType.String(), unmarshalErr.Error())
+	}
+	return parsed.Elem().Interface(), nil
+}
+
+// WaitForTransaction adds a --wait flag to a transaction command, and returns the SendHook which implements
+// it. When the flag is set, the command waits for the transaction it sent to be mined, then reports the status
+// of the transaction, the gas it used, and the JackpotJunction events that it emitted.
+func WaitForTransaction(cmd *cobra.Command) SendHook {
+	var wait bool
+	var waitTimeout uint
+
+	cmd.Flags().BoolVar(&wait, "wait", false, "Wait for the transaction to be mined and print the events it emitted")
+	cmd.Flags().UintVar(&waitTimeout, "wait-timeout", 300, "Maximum time (in seconds) to wait for the transaction to be mined when --wait is set")
+
+	return func(cmd *cobra.Command, client *ethclient.Client, contractAddress common.Address, transactionOpts *bind.TransactOpts) (func(transaction *types.Transaction) error, error) {
+		if !wait {
+			return nil, nil
+		}
+
+		return func(transaction *types.Transaction) error {
+			cmd.Println("Waiting for transaction to be mined...")
+			ctx, cancel := JackpotJunction.NewChainContext(waitTimeout)
+			defer cancel()
+			receipt, receiptErr := JackpotJunction.WaitForReceipt(ctx, client, transaction.Hash(), time.Second)
+			if receiptErr != nil {
+				return receiptErr
+			}
+
+			status := "success"
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				status = "reverted"
+			}
+			cmd.Printf("Transaction mined in block %s: status %s, gas used %d\n", receipt.BlockNumber.String(), status, receipt.GasUsed)
+
+			events, eventsErr := JackpotJunction.DecodeLogs(contractAddress, receipt.Logs)
+			if eventsErr != nil {
+				return eventsErr
+			}
+			for _, event := range events {
+				cmd.Println(DescribeEvent(event))
+			}
+
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				return fmt.Errorf("transaction %s reverted", transaction.Hash().Hex())
+			}
+
+			return nil
+		}, nil
+	}
+}
+
+var ErrAcceptTooLate error = errors.New("accept is not expected to be included before the deadline of the roll")
+
+// GuardAccept adds flags to the accept command which protect players from DeadlineExceeded reverts, and
+// returns the SendHook which implements that protection. Before the transaction is sent, the hook estimates
+// the block it will be included in from the recent block times, and:
+//  1. Refuses to send it if that block is past the deadline of the roll (unless --ignore-deadline is set).
+//  2. Warns if that block is less than --deadline-margin blocks before the deadline.
+//
+// It also previews the outcome of the roll. With --confirm-outcome, it waits for the transaction to be mined
+// and checks that the outcome awarded to the player (and the item, for outcome 1) matches the preview. ETH
+// rewards may differ from the preview, since they depend on the balance of the contract at inclusion.
+func GuardAccept(cmd *cobra.Command) SendHook {
+	var deadlineMargin, blockTimeSamples uint64
+	var submissionDelay time.Duration
+	var ignoreDeadline, confirmOutcome bool
+
+	cmd.Flags().Uint64Var(&deadlineMargin, "deadline-margin", 1, "Warn if accept is expected to be included less than this many blocks before the deadline of the roll")
+	cmd.Flags().DurationVar(&submissionDelay, "submission-delay", time.Second, "Expected time for the transaction to reach the network, used to estimate the block it will be included in")
+	cmd.Flags().Uint64Var(&blockTimeSamples, "block-time-samples", JackpotJunction.DefaultBlockTimeSamples, "Number of recent blocks to estimate the block time from")
+	cmd.Flags().BoolVar(&ignoreDeadline, "ignore-deadline", false, "Send the transaction even if it is not expected to be included before the deadline of the roll")
+	cmd.Flags().BoolVar(&confirmOutcome, "confirm-outcome", false, "Wait for the transaction to be mined (for at most --wait-timeout seconds) and check that the awarded outcome matches the preview")
+
+	return func(cmd *cobra.Command, client *ethclient.Client, contractAddress common.Address, transactionOpts *bind.TransactOpts) (func(transaction *types.Transaction) error, error) {
+		timeout, _ := cmd.Flags().GetUint("timeout")
+
+		windowCtx, cancelWindowCtx := JackpotJunction.NewChainContext(timeout)
+		defer cancelWindowCtx()
+		window, windowErr := JackpotJunction.ReadAcceptWindow(windowCtx, client, client.Client(), contractAddress, transactionOpts.From, blockTimeSamples)
+		if windowErr != nil {
+			return nil, windowErr
+		}
+
+		deadline := window.Deadline()
+		inclusionBlock := window.ExpectedInclusionBlock(time.Now(), submissionDelay)
+		cmd.Printf("Rolled in block %d, deadline is block %d (current block: %s, block time: %s)\n", window.LastRollBlock, deadline, window.Head.Number.String(), window.BlockTime.String())
+		cmd.Printf("Expected inclusion in block %d\n", inclusionBlock)
+
+		if window.Preview.Err != nil {
+			cmd.Printf("Preview unavailable: %s\n", JackpotJunction.DecodeError(window.Preview.Err).Error())
+		} else {
+			cmd.Printf("Preview: outcome %s: %s\n", window.Preview.Value.Outcome.String(), describeReward(window.Preview.Value.Outcome, window.Preview.Value.Reward))
+		}
+
+		if inclusionBlock > deadline {
+			if !ignoreDeadline {
+				return nil, fmt.Errorf("%w: expected inclusion in block %d, deadline is block %d (pass --ignore-deadline to send it anyway)", ErrAcceptTooLate, inclusionBlock, deadline)
+			}
+			cmd.Printf("WARNING: accept is expected to be included %d blocks after the deadline and will probably revert\n", inclusionBlock-deadline)
+		} else if inclusionBlock+deadlineMargin > deadline {
+			cmd.Printf("WARNING: accept is expected to be included only %d blocks before the deadline\n", deadline-inclusionBlock)
+		}
+
+		if !confirmOutcome {
+			return nil, nil
+		}
+
+		return func(transaction *types.Transaction) error {
+			waitTimeout, _ := cmd.Flags().GetUint("wait-timeout")
+			ctx, cancel := JackpotJunction.NewChainContext(waitTimeout)
+			defer cancel()
+			receipt, receiptErr := JackpotJunction.WaitForReceipt(ctx, client, transaction.Hash(), time.Second)
+			if receiptErr != nil {
+				return receiptErr
+			}
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				return fmt.Errorf("accept reverted in block %s", receipt.BlockNumber.String())
+			}
+
+			events, eventsErr := JackpotJunction.DecodeLogs(contractAddress, receipt.Logs)
+			if eventsErr != nil {
+				return eventsErr
+			}
+			for _, event := range events {
+				award, ok := event.(*JackpotJunction.JackpotJunctionAward)
+				if !ok || award.Player != transactionOpts.From {
+					continue
+				}
+
+				cmd.Printf("Accepted in block %s: outcome %s: %s\n", receipt.BlockNumber.String(), award.Outcome.String(), describeReward(award.Outcome, award.Value))
+				if window.Preview.Err != nil {
+					return nil
+				}
+
+				preview := window.Preview.Value
+				if award.Outcome.Cmp(preview.Outcome) != 0 || (award.Outcome.Int64() == 1 && award.Value.Cmp(preview.Reward) != 0) {
+					return fmt.Errorf("the awarded outcome does not match the preview (outcome %s: %s)", preview.Outcome.String(), describeReward(preview.Outcome, preview.Reward))
+				}
+				cmd.Println("The awarded outcome matches the preview")
+				// ETH rewards are a fraction of the balance of the contract when accept is included, which other
+				// players change by rolling and accepting.
+				if award.Outcome.Int64() > 1 && award.Value.Cmp(preview.Reward) != 0 {
+					cmd.Printf("The reward changed from %s ETH to %s ETH since the preview\n", JackpotJunction.FormatEther(preview.Reward), JackpotJunction.FormatEther(award.Value))
+				}
+				return nil
+			}
+
+			return fmt.Errorf("transaction %s did not award an outcome to %s", transaction.Hash().Hex(), transactionOpts.From.Hex())
+		}, nil
+	}
+}
+
+// DescribeEvent returns a human readable description of an event decoded by JackpotJunction.DecodeLogs.
+func DescribeEvent(event interface{}) string {
+	switch e := event.(type) {
+	case *JackpotJunction.JackpotJunctionAward:
+		return fmt.Sprintf("Awarded outcome %s to %s: %s", e.Outcome.String(), e.Player.Hex(), describeReward(e.Outcome, e.Value))
+	case *JackpotJunction.JackpotJunctionRoll:
+		return fmt.Sprintf("Rolled: %s", e.Player.Hex())
+	case *JackpotJunction.JackpotJunctionTierUnlocked:
+		return fmt.Sprintf("Tier unlocked: %s", describePoolID(e.PoolID))
+	case *JackpotJunction.JackpotJunctionTransferSingle:
+		return describeTransfer(e.From, e.To, e.Id, e.Value)
+	case *JackpotJunction.JackpotJunctionTransferBatch:
+		descriptions := make([]string, len(e.Ids))
+		for i := range e.Ids {
+			descriptions[i] = describeTransfer(e.From, e.To, e.Ids[i], e.Values[i])
+		}
+		return strings.Join(descriptions, "\n")
+	case *JackpotJunction.JackpotJunctionApprovalForAll:
+		return fmt.Sprintf("Approval for all: %s set approval of %s to %t", e.Account.Hex(), e.Operator.Hex(), e.Approved)
+	case *JackpotJunction.JackpotJunctionURI:
+		return fmt.Sprintf("URI of %s set to %s", describePoolID(e.Id), e.Value)
+	}
+	return fmt.Sprintf("%v", event)
+}
+
+// Describes the reward for an outcome of a roll, given the value that outcome and accept return for it.
+func describeReward(outcome, value *big.Int) string {
+	switch outcome.Int64() {
+	case 0:
+		return "nothing"
+	case 1:
+		return fmt.Sprintf("item %s", describePoolID(value))
+	}
+	return fmt.Sprintf("%s ETH", JackpotJunction.FormatEther(value))
+}
+
+func describePoolID(poolID *big.Int) string {
+	item, itemErr := items.FromBig(poolID)
+	if itemErr != nil {
+		return poolID.String()
+	}
+	return item.String()
+}
+
+func describeTransfer(from, to common.Address, poolID, value *big.Int) string {
+	if from == (common.Address{}) {
+		return fmt.Sprintf("Minted %s %s to %s", value.String(), describePoolID(poolID), to.Hex())
+	} else if to == (common.Address{}) {
+		return fmt.Sprintf("Burned %s %s from %s", value.String(), describePoolID(poolID), from.Hex())
+	}
+	return fmt.Sprintf("Transferred %s %s from %s to %s", value.String(), describePoolID(poolID), from.Hex(), to.Hex())
+}
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/spf13/cobra"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction"
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction/jjtest"
+)
+
+func TestTransactMethods(t *testing.T) {
+	contractABI, _ := JackpotJunction.JackpotJunctionMetaData.GetAbi()
+	contractCmd := JackpotJunction.CreateJackpotJunctionCommand()
+
+	transactCommands := 0
+	for _, cmd := range contractCmd.Commands() {
+		if cmd.GroupID != "transact" {
+			continue
+		}
+		transactCommands++
+
+		method, methodErr := transactMethod(contractABI, cmd.Name())
+		if methodErr != nil {
+			t.Errorf("%s: %s", cmd.Name(), methodErr.Error())
+			continue
+		}
+
+		argumentFlags, flagsErr := methodArgumentFlags(cmd, method)
+		if flagsErr != nil {
+			t.Errorf("%s: %s", cmd.Name(), flagsErr.Error())
+		} else if len(argumentFlags) != len(method.Inputs) {
+			t.Errorf("%s: expected %d argument flags, got %d", cmd.Name(), len(method.Inputs), len(argumentFlags))
+		}
+	}
+
+	if transactCommands != len(contractABI.Methods)-countViews(contractABI)+1 {
+		t.Errorf("expected a transaction command for every non-view method and for receive, got %d commands", transactCommands)
+	}
+
+	if _, unknownErr := transactMethod(contractABI, "not-a-method"); !errors.Is(unknownErr, ErrUnknownTransactMethod) {
+		t.Errorf("expected ErrUnknownTransactMethod, got %v", unknownErr)
+	}
+}
+
+func TestReceiveCalldata(t *testing.T) {
+	contractABI, _ := JackpotJunction.JackpotJunctionMetaData.GetAbi()
+
+	method, methodErr := transactMethod(contractABI, "receive")
+	if methodErr != nil {
+		t.Fatalf("unexpected error: %s", methodErr.Error())
+	}
+	if method.Type != abi.Receive {
+		t.Fatalf("expected the receive function, got %s", method.String())
+	}
+
+	data, packErr := packCalldata(contractABI, method, nil)
+	if packErr != nil {
+		t.Fatalf("unexpected error: %s", packErr.Error())
+	}
+	if len(data) != 0 {
+		t.Errorf("expected empty calldata for receive, got %x", data)
+	}
+}
+
+func countViews(contractABI *abi.ABI) int {
+	views := 0
+	for _, method := range contractABI.Methods {
+		if method.IsConstant() {
+			views++
+		}
+	}
+	return views
+}
+
+func TestTranslatePoolIDs(t *testing.T) {
+	large := "1606938044258990275541962092341162602522202993782792835301376"
+	cases := map[string]string{
+		"t3-forest-wheels": "90",
+		"90":               "90",
+		large:              large,
+		`["t3-forest-wheels", 6, ` + large + `, "0x1b"]`: `[90,6,` + large + `,27]`,
+	}
+	for raw, expected := range cases {
+		translated, translateErr := translatePoolIDs(raw)
+		if translateErr != nil {
+			t.Errorf("%s: unexpected error: %s", raw, translateErr.Error())
+		} else if translated != expected {
+			t.Errorf("%s: expected %s, got %s", raw, expected, translated)
+		}
+	}
+
+	if _, translateErr := translatePoolIDs("t3-lava-wheels"); translateErr == nil {
+		t.Errorf("expected an error for an unknown terrain")
+	}
+}
+
+// Serves the JSON-RPC API of the given client over HTTP, so that commands which dial an RPC URL can be run
+// against a simulated chain. Single and batched requests are forwarded one call at a time.
+func serveRPC(t *testing.T, client *rpc.Client) string {
+	type request struct {
+		ID     json.RawMessage   `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	type responseError struct {
+		Code    int         `json:"code"`
+		Message string      `json:"message"`
+		Data    interface{} `json:"data,omitempty"`
+	}
+	type response struct {
+		JSONRPC string          `json:"jsonrpc"`
+		ID      json.RawMessage `json:"id"`
+		Result  json.RawMessage `json:"result,omitempty"`
+		Error   *responseError  `json:"error,omitempty"`
+	}
+
+	forward := func(r *http.Request, req request) response {
+		params := make([]interface{}, len(req.Params))
+		for i, param := range req.Params {
+			params[i] = param
+		}
+		var result json.RawMessage
+		callErr := client.CallContext(r.Context(), &result, req.Method, params...)
+		if callErr == nil {
+			if result == nil {
+				result = json.RawMessage("null")
+			}
+			return response{JSONRPC: "2.0", ID: req.ID, Result: result}
+		}
+
+		rpcErr := &responseError{Code: -32000, Message: callErr.Error()}
+		var codeErr rpc.Error
+		if errors.As(callErr, &codeErr) {
+			rpcErr.Code = codeErr.ErrorCode()
+		}
+		var dataErr rpc.DataError
+		if errors.As(callErr, &dataErr) {
+			rpcErr.Data = dataErr.ErrorData()
+		}
+		return response{JSONRPC: "2.0", ID: req.ID, Error: rpcErr}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, readErr := io.ReadAll(r.Body)
+		if readErr != nil {
+			http.Error(w, readErr.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+			var batch []request
+			if unmarshalErr := json.Unmarshal(body, &batch); unmarshalErr != nil {
+				http.Error(w, unmarshalErr.Error(), http.StatusBadRequest)
+				return
+			}
+			responses := make([]response, len(batch))
+			for i, req := range batch {
+				responses[i] = forward(r, req)
+			}
+			json.NewEncoder(w).Encode(responses)
+			return
+		}
+
+		var req request
+		if unmarshalErr := json.Unmarshal(body, &req); unmarshalErr != nil {
+			http.Error(w, unmarshalErr.Error(), http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(forward(r, req))
+	}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+// Runs the subcommand of contractCmd with the given name and arguments, and returns the transaction hash that
+// it printed (if any) along with its output.
+func runTransactCommand(contractCmd *cobra.Command, args ...string) (string, string) {
+	var output bytes.Buffer
+	contractCmd.SetOut(&output)
+	contractCmd.SetErr(&output)
+	contractCmd.SetArgs(args)
+	contractCmd.Execute()
+
+	for _, line := range strings.Split(output.String(), "\n") {
+		if hash, ok := strings.CutPrefix(line, "Transaction hash: "); ok {
+			return hash, output.String()
+		}
+	}
+	return "", output.String()
+}
+
+// TransactWithSigner parses the arguments of the generated transaction commands itself (see its
+// documentation). This test runs every decorated transaction command and the corresponding generated command
+// with the same key, nonce, gas price and gas limit, and checks that they sign the same transaction. It fails
+// if a command is regenerated in a way that the decorated command does not follow.
+func TestTransactionsMatchGenerated(t *testing.T) {
+	h := jjtest.New(t, jjtest.Options{NumPlayers: 1})
+	player := h.Players[0]
+	rpcURL := serveRPC(t, h.RPC())
+
+	// The player must have rolled for GuardAccept to let accept through.
+	if _, rollErr := h.Roll(player); rollErr != nil {
+		t.Fatalf("could not roll: %s", rollErr.Error())
+	}
+	h.Mine(1)
+
+	const password string = "password"
+	ks := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+	account, importErr := ks.ImportECDSA(player.Key, password)
+	if importErr != nil {
+		t.Fatalf("could not create keystore: %s", importErr.Error())
+	}
+	t.Setenv("JJ_TEST_KEY", hex.EncodeToString(crypto.FromECDSA(player.Key)))
+
+	other := common.HexToAddress("0x000000000000000000000000000000000000b0b0").Hex()
+	arguments := map[string][]string{
+		"accept":                     {},
+		"burn":                       {"--pool-id", "90", "--amount", "2"},
+		"burn-batch":                 {"--pool-i-ds", "[1,90]", "--amounts", "[3,4]"},
+		"craft":                      {"--pool-id", "5", "--num-outputs", "7"},
+		"equip":                      {"--pool-i-ds", "[2,30]"},
+		"on-erc-1155-batch-received": {"--arg-0", other, "--arg-1", player.Address.Hex(), "--arg-2", "[1,2]", "--arg-3", "[3,4]", "--arg-4", "beef"},
+		"on-erc-1155-received":       {"--arg-0", other, "--arg-1", player.Address.Hex(), "--arg-2", "1", "--arg-3", "2", "--arg-4", "beef"},
+		"receive":                    {"--value", "1000"},
+		"roll":                       {"--value", h.CostToRoll.String()},
+		"safe-batch-transfer-from":   {"--from-0", player.Address.Hex(), "--to-0", other, "--ids", "[1,2]", "--values", "[3,4]", "--data", ""},
+		"safe-transfer-from":         {"--from-0", player.Address.Hex(), "--to-0", other, "--id", "1", "--value-0", "2", "--data", "01"},
+		"set-approval-for-all":       {"--operator", other, "--approved", "true"},
+		"unequip":                    {},
+	}
+	// Flags which only the decorated commands have.
+	decoratedOnly := map[string][]string{"accept": {"--ignore-deadline"}}
+	sharedArgs := []string{"--rpc", rpcURL, "--contract", h.Address.Hex(), "--nonce", "100", "--gas-price", "1000000000", "--gas-limit", "500000", "--simulate"}
+
+	for _, cmd := range JackpotJunction.CreateJackpotJunctionCommand().Commands() {
+		if cmd.GroupID != "transact" {
+			continue
+		}
+		name := cmd.Name()
+		commandArguments, ok := arguments[name]
+		if !ok {
+			t.Errorf("%s: no arguments for this transaction command -- add them to the test", name)
+			continue
+		}
+
+		generatedArgs := append(append([]string{name, "--keyfile", account.URL.Path, "--password", password}, sharedArgs...), commandArguments...)
+		expected, generatedOutput := runTransactCommand(JackpotJunction.CreateJackpotJunctionCommand(), generatedArgs...)
+		if expected == "" {
+			t.Errorf("%s: the generated command did not sign a transaction:\n%s", name, generatedOutput)
+			continue
+		}
+
+		decorated := JackpotJunction.CreateJackpotJunctionCommand()
+		DecorateContractCommand(decorated)
+		decoratedArgs := append(append([]string{name, "--signer", "key-env:JJ_TEST_KEY"}, sharedArgs...), append(commandArguments, decoratedOnly[name]...)...)
+		actual, decoratedOutput := runTransactCommand(decorated, decoratedArgs...)
+		if actual != expected {
+			t.Errorf("%s: expected transaction %s, got %q:\n%s", name, expected, actual, decoratedOutput)
+		}
+	}
+}
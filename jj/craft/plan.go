@@ -0,0 +1,317 @@
+package craft
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction"
+	"github.com/moonstream-to/degen-trail/jj/items"
+)
+
+var ErrInvalidTier error = errors.New("tier is too large to plan for")
+var ErrRollInProgress error = errors.New("player has a roll in progress, during which items cannot be equipped or unequipped")
+
+// Inventory is a snapshot of the on-chain state of a JackpotJunction contract which is relevant for
+// crafting decisions made by a single player.
+type Inventory struct {
+	Player common.Address
+	// CurrentTier[itemType][terrainType] is the largest tier that has been unlocked for that (itemType, terrainType) pair.
+	CurrentTier [items.NumKinds][items.NumTerrains]uint64
+	// Equipped[itemType] is the pool ID of the item equipped in the corresponding slot, or nil if the slot is empty.
+	// Equipped items are held by the contract, so they are not included in Balances.
+	Equipped [items.NumKinds]*big.Int
+	// Balances maps pool IDs to the number of items of that pool that the player holds in their wallet.
+	Balances map[uint64]*big.Int
+}
+
+// CraftStep represents a single call to craft(poolID, numOutputs) on the JackpotJunction contract.
+type CraftStep struct {
+	PoolID     uint64   `json:"pool_id"`
+	NumOutputs *big.Int `json:"num_outputs"`
+}
+
+// SlotPlan describes how a single inventory slot can be filled with a bonus eligible item.
+type SlotPlan struct {
+	ItemType     uint64      `json:"item_type"`
+	TargetTier   uint64      `json:"target_tier"`
+	TargetPoolID uint64      `json:"target_pool_id"`
+	Equipped     bool        `json:"equipped"`
+	Crafts       []CraftStep `json:"crafts"`
+	// Missing is the number of additional tier 0 items of the given item type and terrain type that the
+	// player would need in order to fill this slot. It is 0 if the slot can be filled.
+	Missing *big.Int `json:"missing"`
+}
+
+// Plan is a sequence of transactions which results in a player having a bonus eligible loadout for a
+// single terrain type. If Unequip is true, the player's equipped items are needed for crafting, and the plan
+// starts with a call to unequip (which returns all of them to the player's wallet).
+type Plan struct {
+	TerrainType uint64                   `json:"terrain_type"`
+	Slots       [items.NumKinds]SlotPlan `json:"slots"`
+	Unequip     bool                     `json:"unequip"`
+	Crafts      []CraftStep              `json:"crafts"`
+	Equip       []uint64                 `json:"equip"`
+	Feasible    bool                     `json:"feasible"`
+	Missing     *big.Int                 `json:"missing"`
+}
+
+// PoolID returns the ERC1155 pool ID for the item with the given item type, terrain type, and tier.
+func PoolID(itemType, terrainType, tier uint64) uint64 {
+	return items.Item{Tier: tier, Terrain: items.Terrain(terrainType), Kind: items.Kind(itemType)}.PoolID()
+}
+
+// Transactions returns the number of transactions required to execute the plan.
+func (p Plan) Transactions() int {
+	numTransactions := len(p.Crafts)
+	if p.Unequip {
+		numTransactions++
+	}
+	if len(p.Equip) > 0 {
+		numTransactions++
+	}
+	return numTransactions
+}
+
+func (inventory Inventory) balance(poolID uint64) *big.Int {
+	balance, ok := inventory.Balances[poolID]
+	if !ok || balance == nil {
+		return new(big.Int)
+	}
+	return balance
+}
+
+// Plans the crafts required to fill the given slot with an item of the given terrain type at the current
+// top tier for that (itemType, terrainType) pair.
+func planSlot(inventory Inventory, itemType, terrainType uint64) SlotPlan {
+	targetTier := inventory.CurrentTier[itemType][terrainType]
+	slot := SlotPlan{
+		ItemType:     itemType,
+		TargetTier:   targetTier,
+		TargetPoolID: PoolID(itemType, terrainType, targetTier),
+		Crafts:       []CraftStep{},
+		Missing:      new(big.Int),
+	}
+
+	equipped := inventory.Equipped[itemType]
+	if equipped != nil && equipped.IsUint64() && equipped.Uint64() == slot.TargetPoolID {
+		slot.Equipped = true
+		return slot
+	}
+
+	// deficits[tier] is the number of items at the given tier that have to be produced by crafting.
+	deficits := make([]*big.Int, targetTier+1)
+	required := big.NewInt(1)
+	lowestTier := targetTier
+	for tier := int64(targetTier); tier >= 0; tier-- {
+		deficit := new(big.Int).Sub(required, inventory.balance(PoolID(itemType, terrainType, uint64(tier))))
+		if deficit.Sign() <= 0 {
+			deficit.SetInt64(0)
+		}
+		deficits[tier] = deficit
+		lowestTier = uint64(tier)
+		if deficit.Sign() == 0 {
+			break
+		}
+		required = new(big.Int).Lsh(deficit, 1)
+	}
+
+	if deficits[lowestTier].Sign() > 0 {
+		// Even the tier 0 items are not enough. Express the shortfall in terms of tier 0 items.
+		slot.Missing.Set(deficits[lowestTier])
+		return slot
+	}
+
+	for tier := lowestTier + 1; tier <= targetTier; tier++ {
+		if deficits[tier].Sign() > 0 {
+			slot.Crafts = append(slot.Crafts, CraftStep{
+				PoolID:     PoolID(itemType, terrainType, tier-1),
+				NumOutputs: deficits[tier],
+			})
+		}
+	}
+
+	return slot
+}
+
+// Unequipped returns the inventory that the player would have after calling unequip: every equipped item
+// is back in the player's wallet.
+func (inventory Inventory) Unequipped() Inventory {
+	unequipped := Inventory{
+		Player:      inventory.Player,
+		CurrentTier: inventory.CurrentTier,
+		Balances:    make(map[uint64]*big.Int, len(inventory.Balances)),
+	}
+	for poolID, balance := range inventory.Balances {
+		unequipped.Balances[poolID] = new(big.Int).Set(balance)
+	}
+	for _, equipped := range inventory.Equipped {
+		if equipped == nil || !equipped.IsUint64() {
+			continue
+		}
+		unequipped.Balances[equipped.Uint64()] = new(big.Int).Add(inventory.balance(equipped.Uint64()), big.NewInt(1))
+	}
+	return unequipped
+}
+
+// PlanForTerrain computes the sequence of transactions that results in the player having current top tier
+// items of the given terrain type in all their slots. Equipped items are only used for crafting (which
+// requires unequipping them first) if the player cannot reach the loadout without them.
+func PlanForTerrain(inventory Inventory, terrainType uint64) Plan {
+	plan := planWithInventory(inventory, terrainType)
+	if plan.Feasible {
+		return plan
+	}
+
+	unequippedPlan := planWithInventory(inventory.Unequipped(), terrainType)
+	if unequippedPlan.Feasible {
+		unequippedPlan.Unequip = true
+		return unequippedPlan
+	}
+	if unequippedPlan.Missing.Cmp(plan.Missing) < 0 {
+		return unequippedPlan
+	}
+	return plan
+}
+
+func planWithInventory(inventory Inventory, terrainType uint64) Plan {
+	plan := Plan{
+		TerrainType: terrainType,
+		Crafts:      []CraftStep{},
+		Equip:       []uint64{},
+		Feasible:    true,
+		Missing:     new(big.Int),
+	}
+
+	for itemType := uint64(0); itemType < items.NumKinds; itemType++ {
+		slot := planSlot(inventory, itemType, terrainType)
+		plan.Slots[itemType] = slot
+
+		if slot.Missing.Sign() > 0 {
+			plan.Feasible = false
+			plan.Missing.Add(plan.Missing, slot.Missing)
+		}
+
+		plan.Crafts = append(plan.Crafts, slot.Crafts...)
+		if !slot.Equipped {
+			plan.Equip = append(plan.Equip, slot.TargetPoolID)
+		}
+	}
+
+	if !plan.Feasible {
+		plan.Crafts = []CraftStep{}
+		plan.Equip = []uint64{}
+	}
+
+	return plan
+}
+
+// BestPlan considers every terrain type and returns the plan that reaches a bonus eligible loadout
+// using the smallest number of transactions. If no terrain type is feasible, it returns the plan which
+// requires the fewest additional tier 0 items (as a report of what the player is missing).
+func BestPlan(inventory Inventory) Plan {
+	var best Plan
+	for terrainType := uint64(0); terrainType < items.NumTerrains; terrainType++ {
+		candidate := PlanForTerrain(inventory, terrainType)
+		if terrainType == 0 {
+			best = candidate
+			continue
+		}
+
+		if candidate.Feasible && !best.Feasible {
+			best = candidate
+		} else if candidate.Feasible && best.Feasible && candidate.Transactions() < best.Transactions() {
+			best = candidate
+		} else if !candidate.Feasible && !best.Feasible && candidate.Missing.Cmp(best.Missing) < 0 {
+			best = candidate
+		}
+	}
+
+	return best
+}
+
+// CheckCanEquip returns an error wrapping ErrRollInProgress if the contract would reject equip and unequip
+// calls from the player in the given block, because the player rolled within the last BlocksToAct blocks
+// and has not accepted the outcome.
+func CheckCanEquip(caller *JackpotJunction.JackpotJunctionCaller, opts *bind.CallOpts, player common.Address, blockNumber uint64) error {
+	lastRollBlock, lastRollBlockErr := caller.LastRollBlock(opts, player)
+	if lastRollBlockErr != nil {
+		return lastRollBlockErr
+	}
+	blocksToAct, blocksToActErr := caller.BlocksToAct(opts)
+	if blocksToActErr != nil {
+		return blocksToActErr
+	}
+
+	deadline := new(big.Int).Add(lastRollBlock, blocksToAct)
+	if deadline.Cmp(new(big.Int).SetUint64(blockNumber)) >= 0 {
+		return fmt.Errorf("%w: accept the outcome of the roll in block %s, or wait until after block %s", ErrRollInProgress, lastRollBlock.String(), deadline.String())
+	}
+	return nil
+}
+
+// ReadInventory reads the current tiers, the player's equipped items, and the player's balances of all
+// pools up to the current top tier from the given JackpotJunction contract.
+func ReadInventory(caller *JackpotJunction.JackpotJunctionCaller, opts *bind.CallOpts, player common.Address) (Inventory, error) {
+	inventory := Inventory{
+		Player:   player,
+		Balances: make(map[uint64]*big.Int),
+	}
+
+	var maxTier uint64
+	for itemType := uint64(0); itemType < items.NumKinds; itemType++ {
+		for terrainType := uint64(0); terrainType < items.NumTerrains; terrainType++ {
+			tier, tierErr := caller.CurrentTier(opts, new(big.Int).SetUint64(itemType), new(big.Int).SetUint64(terrainType))
+			if tierErr != nil {
+				return inventory, tierErr
+			}
+			if !tier.IsUint64() || tier.Uint64() > 64 {
+				return inventory, ErrInvalidTier
+			}
+			inventory.CurrentTier[itemType][terrainType] = tier.Uint64()
+			if tier.Uint64() > maxTier {
+				maxTier = tier.Uint64()
+			}
+		}
+	}
+
+	equippedCalls := [items.NumKinds]func(*bind.CallOpts, common.Address) (*big.Int, error){
+		caller.EquippedCover,
+		caller.EquippedBody,
+		caller.EquippedWheels,
+		caller.EquippedBeasts,
+	}
+	for itemType, equippedCall := range equippedCalls {
+		// The contract stores poolID + 1 so that 0 signifies an empty slot.
+		equipped, equippedErr := equippedCall(opts, player)
+		if equippedErr != nil {
+			return inventory, equippedErr
+		}
+		if equipped.Sign() > 0 {
+			inventory.Equipped[itemType] = new(big.Int).Sub(equipped, big.NewInt(1))
+		}
+	}
+
+	numPools := (maxTier + 1) * items.PoolsPerTier
+	accounts := make([]common.Address, numPools)
+	ids := make([]*big.Int, numPools)
+	for poolID := uint64(0); poolID < numPools; poolID++ {
+		accounts[poolID] = player
+		ids[poolID] = new(big.Int).SetUint64(poolID)
+	}
+
+	balances, balancesErr := caller.BalanceOfBatch(opts, accounts, ids)
+	if balancesErr != nil {
+		return inventory, balancesErr
+	}
+	for poolID, balance := range balances {
+		if balance.Sign() > 0 {
+			inventory.Balances[uint64(poolID)] = balance
+		}
+	}
+
+	return inventory, nil
+}
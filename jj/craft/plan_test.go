@@ -0,0 +1,208 @@
+package craft
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction/jjtest"
+	"github.com/moonstream-to/degen-trail/jj/items"
+)
+
+var forest uint64 = uint64(items.Forest)
+var wheels uint64 = uint64(items.Wheels)
+
+// Returns an inventory in which the given tier is the current tier for every item type and terrain type.
+func inventoryAtTier(tier uint64, balances map[uint64]int64) Inventory {
+	inventory := Inventory{Balances: map[uint64]*big.Int{}}
+	for itemType := range inventory.CurrentTier {
+		for terrainType := range inventory.CurrentTier[itemType] {
+			inventory.CurrentTier[itemType][terrainType] = tier
+		}
+	}
+	for poolID, balance := range balances {
+		inventory.Balances[poolID] = big.NewInt(balance)
+	}
+	return inventory
+}
+
+func TestPlanSlot(t *testing.T) {
+	t0, t1 := PoolID(wheels, forest, 0), PoolID(wheels, forest, 1)
+
+	cases := []struct {
+		name     string
+		tier     uint64
+		balances map[uint64]int64
+		equipped *big.Int
+		crafts   []CraftStep
+		missing  int64
+	}{
+		{"target in wallet", 0, map[uint64]int64{t0: 1}, nil, nil, 0},
+		{"target equipped", 1, nil, big.NewInt(int64(t1)), nil, 0},
+		{"lower tier equipped", 1, map[uint64]int64{t1: 1}, big.NewInt(int64(t0)), nil, 0},
+		{"craft up from tier 0", 2, map[uint64]int64{t0: 4}, nil, []CraftStep{{t0, big.NewInt(2)}, {t1, big.NewInt(1)}}, 0},
+		{"mixed tiers", 2, map[uint64]int64{t0: 2, t1: 1}, nil, []CraftStep{{t0, big.NewInt(1)}, {t1, big.NewInt(1)}}, 0},
+		{"stops at the first tier with enough items", 2, map[uint64]int64{t0: 100, t1: 2}, nil, []CraftStep{{t1, big.NewInt(1)}}, 0},
+		{"one tier 0 item short", 2, map[uint64]int64{t0: 3}, nil, nil, 1},
+		{"partial higher tier reduces the shortfall", 2, map[uint64]int64{t1: 1}, nil, nil, 2},
+		{"empty wallet", 1, nil, nil, nil, 2},
+		{"other terrains do not count", 0, map[uint64]int64{PoolID(wheels, uint64(items.Plains), 0): 5}, nil, nil, 1},
+	}
+
+	for _, c := range cases {
+		inventory := inventoryAtTier(c.tier, c.balances)
+		inventory.Equipped[wheels] = c.equipped
+
+		slot := planSlot(inventory, wheels, forest)
+		if slot.TargetPoolID != PoolID(wheels, forest, c.tier) {
+			t.Errorf("%s: expected target pool %d, got %d", c.name, PoolID(wheels, forest, c.tier), slot.TargetPoolID)
+		}
+		if slot.Equipped != (c.equipped != nil && c.equipped.Uint64() == slot.TargetPoolID) {
+			t.Errorf("%s: unexpected Equipped %t", c.name, slot.Equipped)
+		}
+		if slot.Missing.Int64() != c.missing {
+			t.Errorf("%s: expected %d missing, got %s", c.name, c.missing, slot.Missing.String())
+		}
+		if len(slot.Crafts) != len(c.crafts) {
+			t.Errorf("%s: expected crafts %v, got %v", c.name, c.crafts, slot.Crafts)
+			continue
+		}
+		for i, step := range slot.Crafts {
+			if step.PoolID != c.crafts[i].PoolID || step.NumOutputs.Cmp(c.crafts[i].NumOutputs) != 0 {
+				t.Errorf("%s: expected crafts %v, got %v", c.name, c.crafts, slot.Crafts)
+				break
+			}
+		}
+	}
+}
+
+func TestPlanForTerrain(t *testing.T) {
+	// Each slot has a tier 0 forest item in the wallet and another one equipped, and tier 1 is current.
+	walletAndEquipped := func() Inventory {
+		balances := map[uint64]int64{}
+		for itemType := uint64(0); itemType < items.NumKinds; itemType++ {
+			balances[PoolID(itemType, forest, 0)] = 1
+		}
+		inventory := inventoryAtTier(1, balances)
+		for itemType := uint64(0); itemType < items.NumKinds; itemType++ {
+			inventory.Equipped[itemType] = new(big.Int).SetUint64(PoolID(itemType, forest, 0))
+		}
+		return inventory
+	}
+
+	cases := []struct {
+		name         string
+		inventory    Inventory
+		feasible     bool
+		unequip      bool
+		transactions int
+		missing      int64
+	}{
+		{"equipped items are crafted after unequipping", walletAndEquipped(), true, true, 6, 0},
+		{
+			"equipped items are left alone when the wallet is enough",
+			func() Inventory {
+				inventory := walletAndEquipped()
+				for itemType := uint64(0); itemType < items.NumKinds; itemType++ {
+					inventory.Balances[PoolID(itemType, forest, 0)] = big.NewInt(2)
+				}
+				return inventory
+			}(),
+			true, false, 5, 0,
+		},
+		{
+			"shortfall counts equipped items",
+			func() Inventory {
+				inventory := walletAndEquipped()
+				inventory.Equipped[wheels] = nil
+				return inventory
+			}(),
+			false, false, 0, 1,
+		},
+		{
+			"loadout already equipped",
+			func() Inventory {
+				inventory := inventoryAtTier(1, nil)
+				for itemType := uint64(0); itemType < items.NumKinds; itemType++ {
+					inventory.Equipped[itemType] = new(big.Int).SetUint64(PoolID(itemType, forest, 1))
+				}
+				return inventory
+			}(),
+			true, false, 0, 0,
+		},
+	}
+
+	for _, c := range cases {
+		plan := PlanForTerrain(c.inventory, forest)
+		if plan.Feasible != c.feasible || plan.Unequip != c.unequip {
+			t.Errorf("%s: expected feasible %t and unequip %t, got %t and %t", c.name, c.feasible, c.unequip, plan.Feasible, plan.Unequip)
+		}
+		if plan.Transactions() != c.transactions {
+			t.Errorf("%s: expected %d transactions, got %d", c.name, c.transactions, plan.Transactions())
+		}
+		if plan.Missing.Int64() != c.missing {
+			t.Errorf("%s: expected %d missing, got %s", c.name, c.missing, plan.Missing.String())
+		}
+	}
+}
+
+func TestBestPlanPrefersFewerTransactions(t *testing.T) {
+	plains := uint64(items.Plains)
+	balances := map[uint64]int64{}
+	for itemType := uint64(0); itemType < items.NumKinds; itemType++ {
+		balances[PoolID(itemType, plains, 0)] = 2
+		balances[PoolID(itemType, forest, 1)] = 1
+	}
+
+	plan := BestPlan(inventoryAtTier(1, balances))
+	if plan.TerrainType != forest || plan.Transactions() != 1 {
+		t.Errorf("expected to equip the forest items in a single transaction, got terrain %d with %d transactions", plan.TerrainType, plan.Transactions())
+	}
+}
+
+func TestInventoryOnChain(t *testing.T) {
+	h := jjtest.New(t, jjtest.Options{NumPlayers: 1})
+	player := h.Players[0]
+
+	poolID, itemErr := h.RollForItem(player)
+	if itemErr != nil {
+		t.Fatalf("could not win an item: %s", itemErr.Error())
+	}
+
+	_, rollErr := h.Roll(player)
+	if rollErr != nil {
+		t.Fatalf("could not roll: %s", rollErr.Error())
+	}
+	canEquipErr := CheckCanEquip(&h.Contract.JackpotJunctionCaller, nil, player.Address, h.BlockNumber()+1)
+	if !errors.Is(canEquipErr, ErrRollInProgress) {
+		t.Errorf("expected ErrRollInProgress during a roll, got %v", canEquipErr)
+	}
+	h.Mine(1)
+	if _, acceptErr := h.Accept(player); acceptErr != nil {
+		t.Fatalf("could not accept: %s", acceptErr.Error())
+	}
+	canEquipErr = CheckCanEquip(&h.Contract.JackpotJunctionCaller, nil, player.Address, h.BlockNumber()+1)
+	if canEquipErr != nil {
+		t.Fatalf("expected to be able to equip after accepting, got %s", canEquipErr.Error())
+	}
+
+	_, equipErr := h.Equip(player, poolID)
+	if equipErr != nil {
+		t.Fatalf("could not equip: %s", equipErr.Error())
+	}
+
+	inventory, inventoryErr := ReadInventory(&h.Contract.JackpotJunctionCaller, &bind.CallOpts{}, player.Address)
+	if inventoryErr != nil {
+		t.Fatalf("could not read inventory: %s", inventoryErr.Error())
+	}
+	kind := items.FromPoolID(poolID.Uint64()).Kind
+	if equipped := inventory.Equipped[kind]; equipped == nil || equipped.Cmp(poolID) != 0 {
+		t.Errorf("expected pool %s equipped as %s, got %v", poolID.String(), kind, equipped)
+	}
+	unequipped := inventory.Unequipped()
+	if balance := unequipped.balance(poolID.Uint64()); balance.Cmp(new(big.Int).Add(inventory.balance(poolID.Uint64()), big.NewInt(1))) != 0 {
+		t.Errorf("expected the equipped item to be counted after unequipping, got balance %s", balance.String())
+	}
+}
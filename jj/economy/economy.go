@@ -0,0 +1,133 @@
+package economy
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction"
+)
+
+// Number of distinct outcomes of a roll.
+const NumOutcomes int = 5
+
+// Outcomes are sampled from the low 20 bits of the entropy, so the total mass of each outcome distribution
+// is 2^20.
+const TotalMass uint64 = 1 << 20
+
+// Default cumulative mass functions, as deployed on the JackpotJunction contract.
+var UnmodifiedOutcomesCumulativeMass [NumOutcomes]uint64 = [NumOutcomes]uint64{524288, 933222, 1038079, 1048566, 1048576}
+var ImprovedOutcomesCumulativeMass [NumOutcomes]uint64 = [NumOutcomes]uint64{469283, 878217, 1033074, 1048561, 1048576}
+
+// Parameters are the JackpotJunction contract parameters which determine its economy.
+type Parameters struct {
+	BlocksToAct                      uint64              `json:"blocks_to_act"`
+	CostToRoll                       *big.Int            `json:"cost_to_roll"`
+	CostToReroll                     *big.Int            `json:"cost_to_reroll"`
+	UnmodifiedOutcomesCumulativeMass [NumOutcomes]uint64 `json:"unmodified_outcomes_cumulative_mass"`
+	ImprovedOutcomesCumulativeMass   [NumOutcomes]uint64 `json:"improved_outcomes_cumulative_mass"`
+}
+
+// ReadParameters reads the parameters of a deployed JackpotJunction contract.
+func ReadParameters(caller *JackpotJunction.JackpotJunctionCaller, opts *bind.CallOpts) (Parameters, error) {
+	var parameters Parameters
+
+	blocksToAct, blocksErr := caller.BlocksToAct(opts)
+	if blocksErr != nil {
+		return parameters, blocksErr
+	}
+	parameters.BlocksToAct = blocksToAct.Uint64()
+
+	var costErr error
+	parameters.CostToRoll, costErr = caller.CostToRoll(opts)
+	if costErr != nil {
+		return parameters, costErr
+	}
+	parameters.CostToReroll, costErr = caller.CostToReroll(opts)
+	if costErr != nil {
+		return parameters, costErr
+	}
+
+	for i := 0; i < NumOutcomes; i++ {
+		unmodified, unmodifiedErr := caller.UnmodifiedOutcomesCumulativeMass(opts, big.NewInt(int64(i)))
+		if unmodifiedErr != nil {
+			return parameters, unmodifiedErr
+		}
+		parameters.UnmodifiedOutcomesCumulativeMass[i] = unmodified.Uint64()
+
+		improved, improvedErr := caller.ImprovedOutcomesCumulativeMass(opts, big.NewInt(int64(i)))
+		if improvedErr != nil {
+			return parameters, improvedErr
+		}
+		parameters.ImprovedOutcomesCumulativeMass[i] = improved.Uint64()
+	}
+
+	return parameters, nil
+}
+
+// CumulativeMass returns the cumulative mass function that outcomes are sampled from, depending on whether
+// the player has the bonus.
+func (parameters Parameters) CumulativeMass(bonus bool) [NumOutcomes]uint64 {
+	if bonus {
+		return parameters.ImprovedOutcomesCumulativeMass
+	}
+	return parameters.UnmodifiedOutcomesCumulativeMass
+}
+
+// SampleOutcome mirrors sampleUnmodifiedOutcomeCumulativeMass and sampleImprovedOutcomesCumulativeMass on the
+// contract: it returns the first outcome whose cumulative mass is greater than the sample (the low 20 bits of
+// the entropy).
+func SampleOutcome(cumulativeMass [NumOutcomes]uint64, sample uint64) uint64 {
+	sample = sample % TotalMass
+	for i := 0; i < NumOutcomes-1; i++ {
+		if sample < cumulativeMass[i] {
+			return uint64(i)
+		}
+	}
+	return uint64(NumOutcomes - 1)
+}
+
+// Probabilities returns the probability of each outcome under the given cumulative mass function.
+func Probabilities(cumulativeMass [NumOutcomes]uint64) [NumOutcomes]float64 {
+	var probabilities [NumOutcomes]float64
+	var previous uint64
+	for i := 0; i < NumOutcomes-1; i++ {
+		mass := cumulativeMass[i]
+		if mass < previous {
+			mass = previous
+		}
+		probabilities[i] = float64(mass-previous) / float64(TotalMass)
+		previous = mass
+	}
+	if previous < TotalMass {
+		probabilities[NumOutcomes-1] = float64(TotalMass-previous) / float64(TotalMass)
+	}
+	return probabilities
+}
+
+// Rewards mirrors currentRewards on the contract: the small reward is 1.5 * CostToRoll capped at balance/64,
+// the medium reward is balance/64, and the large reward (the jackpot) is balance/2.
+func Rewards(costToRoll, balance *big.Int) (small, medium, large *big.Int) {
+	small = new(big.Int).Add(costToRoll, new(big.Int).Rsh(costToRoll, 1))
+	medium = new(big.Int).Rsh(balance, 6)
+	if small.Cmp(medium) > 0 {
+		small = new(big.Int).Set(medium)
+	}
+	large = new(big.Int).Rsh(balance, 1)
+	return small, medium, large
+}
+
+// Reward returns the amount of native token paid out for the given outcome when the contract holds the given
+// balance. Outcomes 0 (nothing) and 1 (an item) pay out nothing.
+func Reward(outcome uint64, costToRoll, balance *big.Int) *big.Int {
+	small, medium, large := Rewards(costToRoll, balance)
+	switch outcome {
+	case 2:
+		return small
+	case 3:
+		return medium
+	case 4:
+		return large
+	}
+	return new(big.Int)
+}
@@ -0,0 +1,103 @@
+package economy
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestSampleOutcome(t *testing.T) {
+	cases := []struct {
+		sample   uint64
+		expected uint64
+	}{
+		{0, 0},
+		{524287, 0},
+		{524288, 1},
+		{933221, 1},
+		{933222, 2},
+		{1038078, 2},
+		{1038079, 3},
+		{1048565, 3},
+		{1048566, 4},
+		{1048575, 4},
+		// Only the low 20 bits are sampled.
+		{TotalMass + 524288, 1},
+		{3*TotalMass + 1048570, 4},
+	}
+	for _, c := range cases {
+		if outcome := SampleOutcome(UnmodifiedOutcomesCumulativeMass, c.sample); outcome != c.expected {
+			t.Errorf("sample %d: expected outcome %d, got %d", c.sample, c.expected, outcome)
+		}
+	}
+
+	if outcome := SampleOutcome(ImprovedOutcomesCumulativeMass, 469283); outcome != 1 {
+		t.Errorf("expected outcome 1 at the first boundary of the improved distribution, got %d", outcome)
+	}
+}
+
+func TestProbabilities(t *testing.T) {
+	cases := []struct {
+		name           string
+		cumulativeMass [NumOutcomes]uint64
+		expected       [NumOutcomes]float64
+	}{
+		{
+			"unmodified",
+			UnmodifiedOutcomesCumulativeMass,
+			[NumOutcomes]float64{524288.0 / 1048576, 408934.0 / 1048576, 104857.0 / 1048576, 10487.0 / 1048576, 10.0 / 1048576},
+		},
+		{
+			"improved",
+			ImprovedOutcomesCumulativeMass,
+			[NumOutcomes]float64{469283.0 / 1048576, 408934.0 / 1048576, 154857.0 / 1048576, 15487.0 / 1048576, 15.0 / 1048576},
+		},
+		{
+			"decreasing masses are treated as empty outcomes",
+			[NumOutcomes]uint64{TotalMass / 2, TotalMass / 4, TotalMass, TotalMass, TotalMass},
+			[NumOutcomes]float64{0.5, 0, 0.5, 0, 0},
+		},
+	}
+
+	for _, c := range cases {
+		probabilities := Probabilities(c.cumulativeMass)
+		var total float64
+		for i, probability := range probabilities {
+			total += probability
+			if math.Abs(probability-c.expected[i]) > 1e-12 {
+				t.Errorf("%s: expected probability %g for outcome %d, got %g", c.name, c.expected[i], i, probability)
+			}
+		}
+		if math.Abs(total-1) > 1e-12 {
+			t.Errorf("%s: probabilities sum to %g", c.name, total)
+		}
+	}
+}
+
+func TestRewards(t *testing.T) {
+	cases := []struct {
+		name                 string
+		balance              int64
+		small, medium, large int64
+	}{
+		{"small reward is 1.5 times the cost to roll", 64000, 150, 1000, 32000},
+		{"small reward is capped at the medium reward", 6400, 100, 100, 3200},
+		{"rewards round down", 6463, 100, 100, 3231},
+		{"empty contract", 0, 0, 0, 0},
+	}
+
+	costToRoll := big.NewInt(100)
+	for _, c := range cases {
+		small, medium, large := Rewards(costToRoll, big.NewInt(c.balance))
+		if small.Int64() != c.small || medium.Int64() != c.medium || large.Int64() != c.large {
+			t.Errorf("%s: expected rewards %d, %d, %d, got %s, %s, %s", c.name, c.small, c.medium, c.large, small.String(), medium.String(), large.String())
+		}
+
+		expected := []int64{0, 0, c.small, c.medium, c.large}
+		for outcome, reward := range expected {
+			if actual := Reward(uint64(outcome), costToRoll, big.NewInt(c.balance)); actual.Int64() != reward {
+				t.Errorf("%s: expected reward %d for outcome %d, got %s", c.name, reward, outcome, actual.String())
+			}
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package economy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/moonstream-to/degen-trail/jj/items"
+)
+
+var ErrInvalidPrice error = errors.New("item prices must be non-negative integers (in wei)")
+
+// PriceTable assigns a value (in wei) to items. Items which are not in Prices are worth Default.
+type PriceTable struct {
+	Prices  map[uint64]*big.Int
+	Default *big.Int
+}
+
+// Price returns the value of the item in the given pool.
+func (table PriceTable) Price(poolID uint64) *big.Int {
+	if price, ok := table.Prices[poolID]; ok {
+		return price
+	}
+	if table.Default != nil {
+		return table.Default
+	}
+	return new(big.Int)
+}
+
+// LoadPriceTable reads a price table from a JSON file which maps item names (as accepted by items.Parse) or
+// pool IDs to prices in wei. The special key "default" sets the price of all other items:
+//
+//	{"t0-forest-wheels": "2000000000000000", "default": "1000000000000000"}
+func LoadPriceTable(path string) (PriceTable, error) {
+	table := PriceTable{Prices: make(map[uint64]*big.Int)}
+
+	contents, readErr := os.ReadFile(path)
+	if readErr != nil {
+		return table, readErr
+	}
+
+	// Prices may be given as JSON numbers or as strings.
+	var raw map[string]json.Number
+	unmarshalErr := json.Unmarshal(contents, &raw)
+	if unmarshalErr != nil {
+		return table, unmarshalErr
+	}
+
+	for key, value := range raw {
+		price, ok := new(big.Int).SetString(value.String(), 10)
+		if !ok || price.Sign() < 0 {
+			return table, ErrInvalidPrice
+		}
+
+		if key == "default" {
+			table.Default = price
+			continue
+		}
+
+		item, itemErr := items.Parse(key)
+		if itemErr != nil {
+			return table, itemErr
+		}
+		table.Prices[item.PoolID()] = price
+	}
+
+	return table, nil
+}
+
+// Policy is the stopping policy "accept the first outcome which is at least AcceptAtLeast, and reroll
+// otherwise".
+type Policy struct {
+	AcceptAtLeast uint64 `json:"accept_at_least"`
+	// Expected value (in wei) of the accepted outcome, net of the costs of the initial roll and all rerolls.
+	ExpectedValue float64 `json:"expected_value"`
+	// Expected value (in wei) of rerolling and then following the policy, net of the costs of the rerolls.
+	RerollValue     float64 `json:"reroll_value"`
+	ExpectedRerolls float64 `json:"expected_rerolls"`
+}
+
+// ExpectedValues describes the value of rolling and rerolling for a player.
+type ExpectedValues struct {
+	Balance              *big.Int             `json:"balance"`
+	Bonus                bool                 `json:"bonus"`
+	OutcomeProbabilities [NumOutcomes]float64 `json:"outcome_probabilities"`
+	// Value (in wei) of accepting each outcome. The value of an item outcome is the average price of the 28
+	// tier 0 items, which are equally likely.
+	OutcomeValues [NumOutcomes]float64 `json:"outcome_values"`
+	// Expected value of a fresh roll whose outcome is accepted, net of CostToRoll.
+	RollValue float64 `json:"roll_value"`
+	// Expected value of a single reroll whose outcome is accepted, net of CostToReroll.
+	RerollValue float64 `json:"reroll_value"`
+	// Policies[k] is the policy which accepts outcomes of at least k.
+	Policies [NumOutcomes]Policy `json:"policies"`
+	// The policy with the highest expected value.
+	Optimal Policy `json:"optimal"`
+}
+
+// ComputeExpectedValues computes the expected values of rolling and rerolling for a player, given the
+// contract's balance and whether the player has the bonus.
+//
+// Rewards depend on the balance of the contract when the outcome is accepted, which includes the fees that
+// the player paid. The computation assumes the balance is the given balance plus one CostToRoll, and ignores
+// the (small) increase in rewards from the fees of any rerolls.
+func ComputeExpectedValues(parameters Parameters, balance *big.Int, bonus bool, prices PriceTable) ExpectedValues {
+	values := ExpectedValues{
+		Balance:              balance,
+		Bonus:                bonus,
+		OutcomeProbabilities: Probabilities(parameters.CumulativeMass(bonus)),
+	}
+
+	rollBalance := new(big.Int).Add(balance, parameters.CostToRoll)
+	for outcome := uint64(2); outcome < uint64(NumOutcomes); outcome++ {
+		values.OutcomeValues[outcome] = toFloat(Reward(outcome, parameters.CostToRoll, rollBalance))
+	}
+
+	itemTotal := new(big.Int)
+	for poolID := uint64(0); poolID < items.PoolsPerTier; poolID++ {
+		itemTotal.Add(itemTotal, prices.Price(poolID))
+	}
+	values.OutcomeValues[1] = toFloat(itemTotal) / float64(items.PoolsPerTier)
+
+	var expected float64
+	for outcome := 0; outcome < NumOutcomes; outcome++ {
+		expected += values.OutcomeProbabilities[outcome] * values.OutcomeValues[outcome]
+	}
+	costToRoll := toFloat(parameters.CostToRoll)
+	costToReroll := toFloat(parameters.CostToReroll)
+	values.RollValue = expected - costToRoll
+	values.RerollValue = expected - costToReroll
+
+	// Under the policy "accept outcomes of at least k", the value W of rerolling satisfies
+	// W = sum_{i >= k} p_i v_i + P(outcome < k) W - CostToReroll, so W = (sum_{i >= k} p_i v_i - CostToReroll) / P(outcome >= k).
+	for k := 0; k < NumOutcomes; k++ {
+		var acceptedValue, acceptedProbability float64
+		for outcome := k; outcome < NumOutcomes; outcome++ {
+			acceptedValue += values.OutcomeProbabilities[outcome] * values.OutcomeValues[outcome]
+			acceptedProbability += values.OutcomeProbabilities[outcome]
+		}
+
+		policy := Policy{AcceptAtLeast: uint64(k)}
+		if acceptedProbability > 0 {
+			policy.RerollValue = (acceptedValue - costToReroll) / acceptedProbability
+			policy.ExpectedValue = acceptedValue + (1-acceptedProbability)*policy.RerollValue - costToRoll
+			policy.ExpectedRerolls = (1 - acceptedProbability) / acceptedProbability
+		}
+		values.Policies[k] = policy
+
+		if k == 0 || policy.ExpectedValue > values.Optimal.ExpectedValue {
+			values.Optimal = policy
+		}
+	}
+
+	return values
+}
+
+// ShouldReroll returns true if the player should reroll when they see the given outcome, according to the
+// optimal policy.
+func (values ExpectedValues) ShouldReroll(outcome uint64) bool {
+	return outcome < values.Optimal.AcceptAtLeast
+}
+
+func toFloat(value *big.Int) float64 {
+	result, _ := new(big.Float).SetInt(value).Float64()
+	return result
+}
+
+// WriteExpectedValuesTables writes the expected values to w as human readable tables, with amounts in ETH.
+func WriteExpectedValuesTables(w io.Writer, values ExpectedValues) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	ether := func(wei float64) string {
+		return strconv.FormatFloat(wei/1e18, 'f', 9, 64)
+	}
+
+	fmt.Fprintf(tw, "Contract balance (ETH)\t%s\n", ether(toFloat(values.Balance)))
+	fmt.Fprintf(tw, "Bonus\t%t\n", values.Bonus)
+	fmt.Fprintf(tw, "EV of a roll, accepting the outcome (ETH)\t%s\n", ether(values.RollValue))
+	fmt.Fprintf(tw, "EV of a reroll, accepting the outcome (ETH)\t%s\n", ether(values.RerollValue))
+	fmt.Fprintf(tw, "Optimal policy\taccept outcome >= %d\n", values.Optimal.AcceptAtLeast)
+	fmt.Fprintln(tw)
+
+	fmt.Fprintln(tw, "OUTCOME\tPROBABILITY\tVALUE (ETH)\tOPTIMAL ACTION")
+	for outcome := 0; outcome < NumOutcomes; outcome++ {
+		action := "accept"
+		if values.ShouldReroll(uint64(outcome)) {
+			action = "reroll"
+		}
+		fmt.Fprintf(tw, "%d\t%.8f\t%s\t%s\n", outcome, values.OutcomeProbabilities[outcome], ether(values.OutcomeValues[outcome]), action)
+	}
+	fmt.Fprintln(tw)
+
+	fmt.Fprintln(tw, "POLICY\tEV OF A ROLL (ETH)\tEV OF A REROLL (ETH)\tEXPECTED REROLLS")
+	for _, policy := range values.Policies {
+		fmt.Fprintf(tw, "accept >= %d\t%s\t%s\t%.2f\n", policy.AcceptAtLeast, ether(policy.ExpectedValue), ether(policy.RerollValue), policy.ExpectedRerolls)
+	}
+
+	return tw.Flush()
+}
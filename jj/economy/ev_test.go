@@ -0,0 +1,115 @@
+package economy
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/moonstream-to/degen-trail/jj/items"
+)
+
+var evParameters Parameters = Parameters{
+	BlocksToAct:                      20,
+	CostToRoll:                       big.NewInt(1e15),
+	CostToReroll:                     big.NewInt(25e13),
+	UnmodifiedOutcomesCumulativeMass: UnmodifiedOutcomesCumulativeMass,
+	ImprovedOutcomesCumulativeMass:   ImprovedOutcomesCumulativeMass,
+}
+
+func closeTo(a, b float64) bool {
+	return math.Abs(a-b) <= 1e-9*math.Max(1, math.Max(math.Abs(a), math.Abs(b)))
+}
+
+func TestComputeExpectedValues(t *testing.T) {
+	balance := new(big.Int).Mul(big.NewInt(10), big.NewInt(1e18))
+	prices := PriceTable{Default: big.NewInt(2e14)}
+	values := ComputeExpectedValues(evParameters, balance, false, prices)
+
+	probabilities := Probabilities(UnmodifiedOutcomesCumulativeMass)
+	if values.OutcomeProbabilities != probabilities {
+		t.Errorf("expected the unmodified probabilities, got %v", values.OutcomeProbabilities)
+	}
+
+	rollBalance := new(big.Int).Add(balance, evParameters.CostToRoll)
+	expectedValues := [NumOutcomes]float64{0, 2e14}
+	for outcome := uint64(2); outcome < uint64(NumOutcomes); outcome++ {
+		expectedValues[outcome] = toFloat(Reward(outcome, evParameters.CostToRoll, rollBalance))
+	}
+	if values.OutcomeValues != expectedValues {
+		t.Errorf("expected outcome values %v, got %v", expectedValues, values.OutcomeValues)
+	}
+
+	var expected float64
+	for outcome := range probabilities {
+		expected += probabilities[outcome] * expectedValues[outcome]
+	}
+	if !closeTo(values.RollValue, expected-1e15) || !closeTo(values.RerollValue, expected-25e13) {
+		t.Errorf("expected roll value %g and reroll value %g, got %g and %g", expected-1e15, expected-25e13, values.RollValue, values.RerollValue)
+	}
+
+	// Accepting everything is the same as a single roll.
+	if policy := values.Policies[0]; !closeTo(policy.ExpectedValue, values.RollValue) || policy.ExpectedRerolls != 0 {
+		t.Errorf("expected the policy which accepts everything to be worth a single roll, got %+v", policy)
+	}
+
+	// Accepting outcomes of at least 2: W = (p2 v2 + p3 v3 + p4 v4 - CostToReroll) / P(outcome >= 2).
+	accepted := probabilities[2] + probabilities[3] + probabilities[4]
+	acceptedValue := probabilities[2]*expectedValues[2] + probabilities[3]*expectedValues[3] + probabilities[4]*expectedValues[4]
+	rerollValue := (acceptedValue - 25e13) / accepted
+	policy := values.Policies[2]
+	if !closeTo(policy.RerollValue, rerollValue) || !closeTo(policy.ExpectedValue, acceptedValue+(1-accepted)*rerollValue-1e15) || !closeTo(policy.ExpectedRerolls, (1-accepted)/accepted) {
+		t.Errorf("unexpected values for the policy which accepts outcomes of at least 2: %+v", policy)
+	}
+
+	for _, candidate := range values.Policies {
+		if candidate.ExpectedValue > values.Optimal.ExpectedValue {
+			t.Errorf("policy %+v is better than the optimal policy %+v", candidate, values.Optimal)
+		}
+	}
+	for outcome := uint64(0); outcome < uint64(NumOutcomes); outcome++ {
+		if values.ShouldReroll(outcome) != (outcome < values.Optimal.AcceptAtLeast) {
+			t.Errorf("ShouldReroll(%d) disagrees with the optimal policy %+v", outcome, values.Optimal)
+		}
+	}
+}
+
+func TestOptimalPolicy(t *testing.T) {
+	cases := []struct {
+		name     string
+		balance  *big.Int
+		bonus    bool
+		expected uint64
+	}{
+		// Nothing can be won, so every reroll is wasted.
+		{"empty contract", new(big.Int), false, 0},
+		// The jackpot is worth so much that only it is worth accepting.
+		{"huge jackpot", new(big.Int).Mul(big.NewInt(1e6), big.NewInt(1e18)), false, 4},
+		{"huge jackpot with the bonus", new(big.Int).Mul(big.NewInt(1e6), big.NewInt(1e18)), true, 4},
+	}
+	for _, c := range cases {
+		values := ComputeExpectedValues(evParameters, c.balance, c.bonus, PriceTable{})
+		if values.Optimal.AcceptAtLeast != c.expected {
+			t.Errorf("%s: expected to accept outcomes of at least %d, got %+v", c.name, c.expected, values.Optimal)
+		}
+	}
+}
+
+func TestItemOutcomeValue(t *testing.T) {
+	wheels := items.Item{Tier: 0, Terrain: items.Forest, Kind: items.Wheels}.PoolID()
+	cases := []struct {
+		name     string
+		prices   PriceTable
+		expected float64
+	}{
+		{"no prices", PriceTable{}, 0},
+		{"default price", PriceTable{Default: big.NewInt(28)}, 28},
+		{"single priced item", PriceTable{Prices: map[uint64]*big.Int{wheels: big.NewInt(2800)}}, 100},
+		{"priced item and default", PriceTable{Prices: map[uint64]*big.Int{wheels: big.NewInt(29)}, Default: big.NewInt(1)}, 2},
+	}
+	for _, c := range cases {
+		values := ComputeExpectedValues(evParameters, big.NewInt(1e18), false, c.prices)
+		if !closeTo(values.OutcomeValues[1], c.expected) {
+			t.Errorf("%s: expected an item to be worth %g, got %g", c.name, c.expected, values.OutcomeValues[1])
+		}
+	}
+}
@@ -0,0 +1,420 @@
+package economy
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+var ErrInvalidStrategy error = errors.New("invalid strategy: expected comma-separated key=value pairs with keys players, rate, accept, rerolls, bonus")
+var ErrNoPlayers error = errors.New("the simulation needs at least one player")
+
+// Strategy describes a group of simulated players who all play the same way. Each block, each player who is
+// not in the middle of a roll starts a new roll with probability Rate. After seeing the outcome of a roll, a
+// player accepts it if it is at least AcceptAtLeast or if they have already rerolled MaxRerolls times, and
+// rerolls otherwise.
+type Strategy struct {
+	Players       int     `json:"players"`
+	Rate          float64 `json:"rate"`
+	AcceptAtLeast uint64  `json:"accept_at_least"`
+	MaxRerolls    int     `json:"max_rerolls"`
+	Bonus         bool    `json:"bonus"`
+}
+
+// DefaultStrategy is 10 players who roll about once every 20 blocks and accept every outcome.
+var DefaultStrategy Strategy = Strategy{Players: 10, Rate: 0.05}
+
+// ParseStrategy parses a strategy of the form "players=10,rate=0.05,accept=2,rerolls=3,bonus=true". Keys which
+// are not specified take their values from DefaultStrategy.
+func ParseStrategy(raw string) (Strategy, error) {
+	strategy := DefaultStrategy
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return strategy, ErrInvalidStrategy
+		}
+
+		var parseErr error
+		switch strings.TrimSpace(key) {
+		case "players":
+			strategy.Players, parseErr = strconv.Atoi(value)
+		case "rate":
+			strategy.Rate, parseErr = strconv.ParseFloat(value, 64)
+		case "accept":
+			strategy.AcceptAtLeast, parseErr = strconv.ParseUint(value, 10, 64)
+		case "rerolls":
+			strategy.MaxRerolls, parseErr = strconv.Atoi(value)
+		case "bonus":
+			strategy.Bonus, parseErr = strconv.ParseBool(value)
+		default:
+			return strategy, ErrInvalidStrategy
+		}
+		if parseErr != nil {
+			return strategy, ErrInvalidStrategy
+		}
+	}
+
+	if strategy.Players < 0 || strategy.Rate < 0 || strategy.Rate > 1 || strategy.MaxRerolls < 0 {
+		return strategy, ErrInvalidStrategy
+	}
+
+	return strategy, nil
+}
+
+// Simulation configures a Monte Carlo simulation of the contract's native token balance.
+type Simulation struct {
+	Parameters     Parameters
+	InitialBalance *big.Int
+	Strategies     []Strategy
+	// Number of blocks in each run, and number of runs.
+	Blocks uint64
+	Runs   int
+	// A run counts as drained if the balance ever falls below this threshold (in wei).
+	DrainThreshold *big.Int
+	// Number of points in the reported balance trajectory.
+	TrajectoryPoints int
+	Seed             int64
+}
+
+// Summary describes the distribution of a quantity across runs.
+type Summary struct {
+	Mean   float64 `json:"mean"`
+	StdDev float64 `json:"std_dev"`
+	Min    float64 `json:"min"`
+	P5     float64 `json:"p5"`
+	P50    float64 `json:"p50"`
+	P95    float64 `json:"p95"`
+	Max    float64 `json:"max"`
+}
+
+// TrajectoryPoint describes the distribution of the balance (in wei) across runs at a given block.
+type TrajectoryPoint struct {
+	Block uint64  `json:"block"`
+	Mean  float64 `json:"mean"`
+	P5    float64 `json:"p5"`
+	P50   float64 `json:"p50"`
+	P95   float64 `json:"p95"`
+}
+
+// SimulationResult is the result of Simulate. Amounts are in wei. They are computed in floating point and
+// are approximate.
+type SimulationResult struct {
+	Runs       int               `json:"runs"`
+	Blocks     uint64            `json:"blocks"`
+	Trajectory []TrajectoryPoint `json:"trajectory"`
+	// Distributions across runs.
+	FinalBalance  Summary `json:"final_balance"`
+	MinBalance    Summary `json:"min_balance"`
+	FeesCollected Summary `json:"fees_collected"`
+	PaidOut       Summary `json:"paid_out"`
+	Rolls         Summary `json:"rolls"`
+	Rerolls       Summary `json:"rerolls"`
+	// Mean and standard deviation of the native token paid out by a single accepted outcome, across all runs.
+	PayoutMean   float64 `json:"payout_mean"`
+	PayoutStdDev float64 `json:"payout_std_dev"`
+	// Fraction of accepted outcomes of each kind, across all runs.
+	OutcomeFrequencies [NumOutcomes]float64 `json:"outcome_frequencies"`
+	// Fraction of runs with at least one jackpot, and the distribution of the block of the first jackpot over
+	// those runs.
+	JackpotProbability float64 `json:"jackpot_probability"`
+	FirstJackpotBlock  Summary `json:"first_jackpot_block"`
+	// Fraction of runs in which the balance fell below the drain threshold.
+	DrainThreshold   float64 `json:"drain_threshold"`
+	DrainProbability float64 `json:"drain_probability"`
+}
+
+type simulatedPlayer struct {
+	strategy      Strategy
+	rolling       bool
+	lastRollBlock uint64
+	rerolls       int
+}
+
+// Simulate runs the simulation. Each block, players act in a random order: players in the middle of a roll
+// see its outcome and accept or reroll, and other players may start a new roll. Outcomes are sampled from the
+// cumulative mass function for the player's strategy, and rewards are computed from the balance at the time
+// the outcome is accepted, as on the contract.
+func Simulate(simulation Simulation) (SimulationResult, error) {
+	result := SimulationResult{Runs: simulation.Runs, Blocks: simulation.Blocks}
+
+	numPlayers := 0
+	for _, strategy := range simulation.Strategies {
+		numPlayers += strategy.Players
+	}
+	if numPlayers == 0 {
+		return result, ErrNoPlayers
+	}
+
+	costToRoll, _ := new(big.Float).SetInt(simulation.Parameters.CostToRoll).Float64()
+	costToReroll, _ := new(big.Float).SetInt(simulation.Parameters.CostToReroll).Float64()
+	initialBalance, _ := new(big.Float).SetInt(simulation.InitialBalance).Float64()
+	drainThreshold := 0.0
+	if simulation.DrainThreshold != nil {
+		drainThreshold, _ = new(big.Float).SetInt(simulation.DrainThreshold).Float64()
+	}
+	result.DrainThreshold = drainThreshold
+
+	numPoints := simulation.TrajectoryPoints
+	if numPoints < 2 {
+		numPoints = 2
+	}
+	pointBlocks := make([]uint64, numPoints)
+	for i := range pointBlocks {
+		pointBlocks[i] = simulation.Blocks * uint64(i) / uint64(numPoints-1)
+	}
+	trajectories := make([][]float64, numPoints)
+
+	finalBalances := make([]float64, simulation.Runs)
+	minBalances := make([]float64, simulation.Runs)
+	fees := make([]float64, simulation.Runs)
+	paidOut := make([]float64, simulation.Runs)
+	rolls := make([]float64, simulation.Runs)
+	rerolls := make([]float64, simulation.Runs)
+	firstJackpots := []float64{}
+	drained := 0
+
+	var outcomeCounts [NumOutcomes]float64
+	var numPayouts, payoutMean, payoutM2 float64
+
+	for run := 0; run < simulation.Runs; run++ {
+		rng := rand.New(rand.NewSource(simulation.Seed + int64(run)))
+
+		players := make([]simulatedPlayer, 0, numPlayers)
+		for _, strategy := range simulation.Strategies {
+			for i := 0; i < strategy.Players; i++ {
+				players = append(players, simulatedPlayer{strategy: strategy})
+			}
+		}
+
+		balance := initialBalance
+		minBalance := balance
+		firstJackpot, hasJackpot := uint64(0), false
+		pointIndex := 0
+
+		for block := uint64(0); block <= simulation.Blocks; block++ {
+			for pointIndex < numPoints && pointBlocks[pointIndex] == block {
+				trajectories[pointIndex] = append(trajectories[pointIndex], balance)
+				pointIndex++
+			}
+			if block == simulation.Blocks {
+				break
+			}
+
+			rng.Shuffle(len(players), func(i, j int) { players[i], players[j] = players[j], players[i] })
+			for i := range players {
+				player := &players[i]
+
+				// A roll expires if the player does not act within BlocksToAct blocks. Simulated players always
+				// act in the block after they roll, so this only matters if BlocksToAct is 0.
+				if player.rolling && block > player.lastRollBlock+simulation.Parameters.BlocksToAct {
+					player.rolling = false
+				}
+
+				if !player.rolling {
+					if rng.Float64() < player.strategy.Rate {
+						balance += costToRoll
+						fees[run] += costToRoll
+						rolls[run]++
+						player.rolling = true
+						player.lastRollBlock = block
+						player.rerolls = 0
+					}
+					continue
+				}
+
+				if block <= player.lastRollBlock {
+					continue
+				}
+
+				outcome := SampleOutcome(simulation.Parameters.CumulativeMass(player.strategy.Bonus), uint64(rng.Int63n(int64(TotalMass))))
+				if outcome < player.strategy.AcceptAtLeast && player.rerolls < player.strategy.MaxRerolls {
+					balance += costToReroll
+					fees[run] += costToReroll
+					rerolls[run]++
+					player.lastRollBlock = block
+					player.rerolls++
+					continue
+				}
+
+				payout := rewardFloat(outcome, costToRoll, balance)
+				balance -= payout
+				paidOut[run] += payout
+				player.rolling = false
+
+				outcomeCounts[outcome]++
+				numPayouts++
+				delta := payout - payoutMean
+				payoutMean += delta / numPayouts
+				payoutM2 += delta * (payout - payoutMean)
+
+				if outcome == 4 && !hasJackpot {
+					firstJackpot, hasJackpot = block, true
+				}
+			}
+
+			if balance < minBalance {
+				minBalance = balance
+			}
+		}
+
+		finalBalances[run] = balance
+		minBalances[run] = minBalance
+		if hasJackpot {
+			firstJackpots = append(firstJackpots, float64(firstJackpot))
+		}
+		if minBalance < drainThreshold {
+			drained++
+		}
+	}
+
+	result.Trajectory = make([]TrajectoryPoint, numPoints)
+	for i, balances := range trajectories {
+		summary := summarize(balances)
+		result.Trajectory[i] = TrajectoryPoint{Block: pointBlocks[i], Mean: summary.Mean, P5: summary.P5, P50: summary.P50, P95: summary.P95}
+	}
+
+	result.FinalBalance = summarize(finalBalances)
+	result.MinBalance = summarize(minBalances)
+	result.FeesCollected = summarize(fees)
+	result.PaidOut = summarize(paidOut)
+	result.Rolls = summarize(rolls)
+	result.Rerolls = summarize(rerolls)
+
+	result.PayoutMean = payoutMean
+	if numPayouts > 1 {
+		result.PayoutStdDev = math.Sqrt(payoutM2 / (numPayouts - 1))
+	}
+	if numPayouts > 0 {
+		for i := range outcomeCounts {
+			result.OutcomeFrequencies[i] = outcomeCounts[i] / numPayouts
+		}
+	}
+
+	if simulation.Runs > 0 {
+		result.JackpotProbability = float64(len(firstJackpots)) / float64(simulation.Runs)
+		result.DrainProbability = float64(drained) / float64(simulation.Runs)
+	}
+	result.FirstJackpotBlock = summarize(firstJackpots)
+
+	return result, nil
+}
+
+// Floating point version of Reward, rounding down as the contract does.
+func rewardFloat(outcome uint64, costToRoll, balance float64) float64 {
+	medium := math.Floor(balance / 64)
+	switch outcome {
+	case 2:
+		return math.Min(math.Floor(1.5*costToRoll), medium)
+	case 3:
+		return medium
+	case 4:
+		return math.Floor(balance / 2)
+	}
+	return 0
+}
+
+func summarize(values []float64) Summary {
+	if len(values) == 0 {
+		return Summary{}
+	}
+
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	var sum float64
+	for _, value := range sorted {
+		sum += value
+	}
+	mean := sum / float64(len(sorted))
+
+	var squares float64
+	for _, value := range sorted {
+		squares += (value - mean) * (value - mean)
+	}
+	stdDev := 0.0
+	if len(sorted) > 1 {
+		stdDev = math.Sqrt(squares / float64(len(sorted)-1))
+	}
+
+	percentile := func(p float64) float64 {
+		return sorted[int(math.Round(p*float64(len(sorted)-1)))]
+	}
+
+	return Summary{
+		Mean:   mean,
+		StdDev: stdDev,
+		Min:    sorted[0],
+		P5:     percentile(0.05),
+		P50:    percentile(0.5),
+		P95:    percentile(0.95),
+		Max:    sorted[len(sorted)-1],
+	}
+}
+
+// WriteSimulationTables writes the result of a simulation to w as human readable tables, with amounts in
+// ETH.
+func WriteSimulationTables(w io.Writer, result SimulationResult) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	ether := func(wei float64) string {
+		return strconv.FormatFloat(wei/1e18, 'f', 6, 64)
+	}
+
+	fmt.Fprintf(tw, "Runs\t%d\n", result.Runs)
+	fmt.Fprintf(tw, "Blocks per run\t%d\n", result.Blocks)
+	fmt.Fprintf(tw, "Payout per accepted outcome (ETH)\tmean %s, std dev %s\n", ether(result.PayoutMean), ether(result.PayoutStdDev))
+	fmt.Fprintf(tw, "Probability of a jackpot\t%.4f\n", result.JackpotProbability)
+	if result.JackpotProbability > 0 {
+		fmt.Fprintf(tw, "Block of first jackpot\tmean %.1f, median %.0f, p95 %.0f\n", result.FirstJackpotBlock.Mean, result.FirstJackpotBlock.P50, result.FirstJackpotBlock.P95)
+	}
+	fmt.Fprintf(tw, "Probability of draining below %s ETH\t%.4f\n", ether(result.DrainThreshold), result.DrainProbability)
+	fmt.Fprintln(tw)
+
+	fmt.Fprintln(tw, "OUTCOME\tFREQUENCY")
+	for i, frequency := range result.OutcomeFrequencies {
+		fmt.Fprintf(tw, "%d\t%.6f\n", i, frequency)
+	}
+	fmt.Fprintln(tw)
+
+	fmt.Fprintln(tw, "PER RUN\tMEAN\tSTD DEV\tP5\tMEDIAN\tP95")
+	for _, row := range []struct {
+		name    string
+		summary Summary
+		isWei   bool
+	}{
+		{"Final balance (ETH)", result.FinalBalance, true},
+		{"Minimum balance (ETH)", result.MinBalance, true},
+		{"Fees collected (ETH)", result.FeesCollected, true},
+		{"Paid out (ETH)", result.PaidOut, true},
+		{"Rolls", result.Rolls, false},
+		{"Rerolls", result.Rerolls, false},
+	} {
+		format := func(value float64) string {
+			if row.isWei {
+				return ether(value)
+			}
+			return strconv.FormatFloat(value, 'f', 1, 64)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", row.name, format(row.summary.Mean), format(row.summary.StdDev), format(row.summary.P5), format(row.summary.P50), format(row.summary.P95))
+	}
+	fmt.Fprintln(tw)
+
+	fmt.Fprintln(tw, "BLOCK\tMEAN BALANCE (ETH)\tP5\tMEDIAN\tP95")
+	for _, point := range result.Trajectory {
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\n", point.Block, ether(point.Mean), ether(point.P5), ether(point.P50), ether(point.P95))
+	}
+
+	return tw.Flush()
+}
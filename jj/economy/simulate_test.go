@@ -0,0 +1,137 @@
+package economy
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestParseStrategy(t *testing.T) {
+	cases := []struct {
+		raw      string
+		expected Strategy
+		err      error
+	}{
+		{"", DefaultStrategy, nil},
+		{"players=3, rate=0.5", Strategy{Players: 3, Rate: 0.5}, nil},
+		{"accept=2,rerolls=4,bonus=true", Strategy{Players: 10, Rate: 0.05, AcceptAtLeast: 2, MaxRerolls: 4, Bonus: true}, nil},
+		{"players", Strategy{}, ErrInvalidStrategy},
+		{"lives=9", Strategy{}, ErrInvalidStrategy},
+		{"rate=1.5", Strategy{}, ErrInvalidStrategy},
+		{"rerolls=-1", Strategy{}, ErrInvalidStrategy},
+		{"bonus=maybe", Strategy{}, ErrInvalidStrategy},
+	}
+	for _, c := range cases {
+		strategy, parseErr := ParseStrategy(c.raw)
+		if !errors.Is(parseErr, c.err) {
+			t.Errorf("%q: expected error %v, got %v", c.raw, c.err, parseErr)
+		} else if c.err == nil && strategy != c.expected {
+			t.Errorf("%q: expected %+v, got %+v", c.raw, c.expected, strategy)
+		}
+	}
+}
+
+func testSimulation(strategies ...Strategy) Simulation {
+	return Simulation{
+		Parameters: Parameters{
+			BlocksToAct:                      20,
+			CostToRoll:                       big.NewInt(1e15),
+			CostToReroll:                     big.NewInt(25e13),
+			UnmodifiedOutcomesCumulativeMass: UnmodifiedOutcomesCumulativeMass,
+			ImprovedOutcomesCumulativeMass:   ImprovedOutcomesCumulativeMass,
+		},
+		InitialBalance:   big.NewInt(1e18),
+		Strategies:       strategies,
+		Blocks:           2000,
+		Runs:             20,
+		DrainThreshold:   big.NewInt(5e17),
+		TrajectoryPoints: 5,
+		Seed:             1729,
+	}
+}
+
+func TestSimulateIsReproducible(t *testing.T) {
+	simulation := testSimulation(Strategy{Players: 5, Rate: 0.1, AcceptAtLeast: 2, MaxRerolls: 2})
+
+	first, firstErr := Simulate(simulation)
+	if firstErr != nil {
+		t.Fatalf("unexpected error: %s", firstErr.Error())
+	}
+	second, _ := Simulate(simulation)
+	if first.FinalBalance != second.FinalBalance || first.OutcomeFrequencies != second.OutcomeFrequencies {
+		t.Errorf("simulations with the same seed differ: %+v and %+v", first.FinalBalance, second.FinalBalance)
+	}
+
+	simulation.Seed++
+	reseeded, _ := Simulate(simulation)
+	if reseeded.FinalBalance == first.FinalBalance {
+		t.Errorf("simulations with different seeds have the same final balances")
+	}
+}
+
+func TestSimulateAccounting(t *testing.T) {
+	cases := []struct {
+		name       string
+		strategy   Strategy
+		noRerolls  bool
+		noActivity bool
+	}{
+		{"accept everything", Strategy{Players: 10, Rate: 0.05}, true, false},
+		{"reroll for rewards", Strategy{Players: 10, Rate: 0.05, AcceptAtLeast: 2, MaxRerolls: 3}, false, false},
+		{"bonus", Strategy{Players: 10, Rate: 0.05, Bonus: true}, true, false},
+		{"nobody rolls", Strategy{Players: 10}, true, true},
+	}
+
+	for _, c := range cases {
+		simulation := testSimulation(c.strategy)
+		result, simulateErr := Simulate(simulation)
+		if simulateErr != nil {
+			t.Fatalf("%s: unexpected error: %s", c.name, simulateErr.Error())
+		}
+
+		// The balance only changes through fees and payouts.
+		initial := 1e18
+		if expected := initial + result.FeesCollected.Mean - result.PaidOut.Mean; math.Abs(result.FinalBalance.Mean-expected) > 1e-6*initial {
+			t.Errorf("%s: expected a mean final balance of %g, got %g", c.name, expected, result.FinalBalance.Mean)
+		}
+		if expected := result.Rolls.Mean*1e15 + result.Rerolls.Mean*25e13; math.Abs(result.FeesCollected.Mean-expected) > 1e-6*initial {
+			t.Errorf("%s: expected mean fees of %g, got %g", c.name, expected, result.FeesCollected.Mean)
+		}
+		if result.MinBalance.Max > result.FinalBalance.Max || result.MinBalance.Max > initial {
+			t.Errorf("%s: minimum balances %+v exceed final or initial balances", c.name, result.MinBalance)
+		}
+		if len(result.Trajectory) != 5 || result.Trajectory[0].Block != 0 || result.Trajectory[4].Block != simulation.Blocks || result.Trajectory[0].Mean != initial {
+			t.Errorf("%s: unexpected trajectory %+v", c.name, result.Trajectory)
+		}
+		if c.noRerolls && result.Rerolls.Max != 0 {
+			t.Errorf("%s: expected no rerolls, got %+v", c.name, result.Rerolls)
+		}
+
+		if c.noActivity {
+			if result.Rolls.Max != 0 || result.FinalBalance.Min != initial || result.DrainProbability != 0 {
+				t.Errorf("%s: expected no activity, got %+v", c.name, result)
+			}
+			continue
+		}
+
+		var total float64
+		for _, frequency := range result.OutcomeFrequencies {
+			total += frequency
+		}
+		if math.Abs(total-1) > 1e-9 {
+			t.Errorf("%s: outcome frequencies sum to %g", c.name, total)
+		}
+		if c.strategy.AcceptAtLeast == 0 {
+			// About 20000 accepted outcomes, so the frequency of outcome 0 should be close to its probability.
+			expected := Probabilities(simulation.Parameters.CumulativeMass(c.strategy.Bonus))[0]
+			if math.Abs(result.OutcomeFrequencies[0]-expected) > 0.02 {
+				t.Errorf("%s: expected outcome 0 with frequency close to %g, got %g", c.name, expected, result.OutcomeFrequencies[0])
+			}
+		}
+	}
+
+	if _, noPlayersErr := Simulate(testSimulation()); !errors.Is(noPlayersErr, ErrNoPlayers) {
+		t.Errorf("expected ErrNoPlayers, got %v", noPlayersErr)
+	}
+}
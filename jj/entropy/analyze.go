@@ -11,82 +11,57 @@ import (
 
 var ErrParseBlockHash error = errors.New("could not parse block number")
 
-func Entropies(blocks []BlockResult, player string) (float64, float64, float64) {
-	one := big.NewInt(1)
-	two := big.NewInt(2)
-	four := big.NewInt(4)
-	seven := big.NewInt(7)
-	twenty := big.NewInt(20)
-	onehundredeighteen := big.NewInt(118)
-	outcomeMass := new(big.Int)
-	outcomeMass.Exp(two, twenty, nil)
+// Reductions are the values that the JackpotJunction contract derives from the entropy of a roll.
+type Reductions struct {
+	// Item type of the item awarded for outcome 1: bits 138 to 255 of the entropy, modulo 4.
+	ItemType int64
+	// Terrain type of the item awarded for outcome 1: bits 20 to 137 of the entropy, modulo 7.
+	TerrainType int64
+	// The sample that the outcome is drawn with: the low 20 bits of the entropy.
+	OutcomeSample int64
+}
 
-	outcomeMask := new(big.Int)
-	outcomeMask.Exp(two, twenty, nil)
-	outcomeMask.Sub(outcomeMask, one)
+var outcomeMask *big.Int = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 20), big.NewInt(1))
+var terrainMask *big.Int = new(big.Int).Lsh(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 118), big.NewInt(1)), 20)
+
+// Entropy mirrors _entropy on the JackpotJunction contract: the randomness for a roll is the keccak256 hash
+// of the ABI encoding of the hash of the block the player rolled in and the player's address.
+func Entropy(blockHash common.Hash, player common.Address) *big.Int {
+	hashBytes := crypto.Keccak256(blockHash.Bytes(), common.LeftPadBytes(player.Bytes(), 32))
+	return new(big.Int).SetBytes(hashBytes)
+}
 
-	terrainMask := new(big.Int)
-	terrainMask.Exp(two, onehundredeighteen, nil)
-	terrainMask.Sub(terrainMask, one)
-	terrainMask.Lsh(terrainMask, 20)
+// Reduce computes the reductions of the given entropy, as in the outcome method on the JackpotJunction
+// contract.
+func Reduce(entropy *big.Int) Reductions {
+	itemRNG := new(big.Int).Rsh(entropy, 138)
+	itemRNG.Mod(itemRNG, big.NewInt(4))
 
-	itemMask := new(big.Int)
-	itemMask.Set(terrainMask)
-	itemMask.Lsh(itemMask, 118)
+	terrainRNG := new(big.Int).And(entropy, terrainMask)
+	terrainRNG.Rsh(terrainRNG, 20)
+	terrainRNG.Mod(terrainRNG, big.NewInt(7))
+
+	outcomeRNG := new(big.Int).And(entropy, outcomeMask)
+
+	return Reductions{ItemType: itemRNG.Int64(), TerrainType: terrainRNG.Int64(), OutcomeSample: outcomeRNG.Int64()}
+}
 
+func Entropies(blocks []BlockResult, player string) (float64, float64, float64) {
 	index := make(map[string]bool)
 	itemReductionFrequencies := make(map[int64]int)
 	terrainReductionFrequencies := make(map[int64]int)
 	outcomeReductionFrequencies := make(map[int64]int)
 
+	address := common.HexToAddress(player)
 	for _, block := range blocks {
 		_, blockNumberProcessed := index[block.Number]
 		if !blockNumberProcessed {
 			index[block.Number] = true
 
-			blockhash := common.HexToHash(block.Hash)
-			address := common.HexToAddress(player)
-			data := append(blockhash.Bytes(), address.Bytes()...)
-
-			hashBytes := crypto.Keccak256(data)
-
-			value := new(big.Int)
-			value.SetBytes(hashBytes)
-
-			itemRNG := new(big.Int)
-			itemRNG.And(value, itemMask)
-			itemRNG.Rsh(itemRNG, 138)
-			itemRNG.Mod(itemRNG, four)
-			itemReduction := itemRNG.Int64()
-			_, itemReductionExists := itemReductionFrequencies[itemReduction]
-			if itemReductionExists {
-				itemReductionFrequencies[itemReduction] += 1
-			} else {
-				itemReductionFrequencies[itemReduction] = 1
-			}
-
-			terrainRNG := new(big.Int)
-			terrainRNG.And(value, terrainMask)
-			terrainRNG.Rsh(terrainRNG, 20)
-			terrainRNG.Mod(terrainRNG, seven)
-			terrainReduction := terrainRNG.Int64()
-			_, terrainReductionExists := terrainReductionFrequencies[terrainReduction]
-			if terrainReductionExists {
-				terrainReductionFrequencies[terrainReduction] += 1
-			} else {
-				terrainReductionFrequencies[terrainReduction] = 1
-			}
-
-			outcomeRNG := new(big.Int)
-			outcomeRNG.And(value, outcomeMask)
-			outcomeRNG.Mod(outcomeRNG, outcomeMass)
-			outcomeReduction := outcomeRNG.Int64()
-			_, outcomeReductionExists := outcomeReductionFrequencies[outcomeReduction]
-			if outcomeReductionExists {
-				outcomeReductionFrequencies[outcomeReduction] += 1
-			} else {
-				outcomeReductionFrequencies[outcomeReduction] = 1
-			}
+			reductions := Reduce(Entropy(common.HexToHash(block.Hash), address))
+			itemReductionFrequencies[reductions.ItemType] += 1
+			terrainReductionFrequencies[reductions.TerrainType] += 1
+			outcomeReductionFrequencies[reductions.OutcomeSample] += 1
 		}
 	}
 
@@ -0,0 +1,87 @@
+package entropy
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction/jjtest"
+)
+
+// Entropy must match keccak256(abi.encode(blockhash, player)), in which the address is padded on the left to 32
+// bytes.
+func TestEntropyMatchesABIEncoding(t *testing.T) {
+	bytes32, _ := abi.NewType("bytes32", "", nil)
+	address, _ := abi.NewType("address", "", nil)
+	arguments := abi.Arguments{{Type: bytes32}, {Type: address}}
+
+	blockHash := crypto.Keccak256Hash([]byte("block"))
+	for _, player := range []common.Address{
+		{},
+		common.HexToAddress("0x1"),
+		common.HexToAddress("0xa11ce"),
+		common.HexToAddress("0xffffffffffffffffffffffffffffffffffffffff"),
+	} {
+		encoded, packErr := arguments.Pack(blockHash, player)
+		if packErr != nil {
+			t.Fatalf("unexpected error: %s", packErr.Error())
+		}
+		expected := new(big.Int).SetBytes(crypto.Keccak256(encoded))
+		if entropy := Entropy(blockHash, player); entropy.Cmp(expected) != 0 {
+			t.Errorf("%s: expected entropy %s, got %s", player.Hex(), expected.String(), entropy.String())
+		}
+	}
+}
+
+// Compares Entropy and Reduce with the entropy and item rewards computed by the outcome method on a simulated
+// chain.
+func TestEntropyMatchesContract(t *testing.T) {
+	h := jjtest.New(t, jjtest.Options{NumPlayers: 4})
+
+	items := 0
+	for round := 0; round < 50; round++ {
+		for _, player := range h.Players {
+			if _, rollErr := h.Roll(player); rollErr != nil {
+				t.Fatalf("could not roll: %s", rollErr.Error())
+			}
+		}
+		h.Mine(1)
+
+		for _, player := range h.Players {
+			lastRollBlock, lastRollBlockErr := h.Contract.LastRollBlock(nil, player.Address)
+			if lastRollBlockErr != nil {
+				t.Fatalf("could not get LastRollBlock: %s", lastRollBlockErr.Error())
+			}
+			header, headerErr := h.Client.HeaderByNumber(context.Background(), lastRollBlock)
+			if headerErr != nil {
+				t.Fatalf("could not get block %s: %s", lastRollBlock.String(), headerErr.Error())
+			}
+			outcome, outcomeErr := h.Outcome(player)
+			if outcomeErr != nil {
+				t.Fatalf("could not preview outcome: %s", outcomeErr.Error())
+			}
+
+			entropy := Entropy(header.Hash(), player.Address)
+			if entropy.Cmp(outcome.Entropy) != 0 {
+				t.Fatalf("%s in block %s: contract computed entropy %s, Entropy returned %s", player.Address.Hex(), lastRollBlock.String(), outcome.Entropy.String(), entropy.String())
+			}
+
+			// Items awarded for outcome 1 are tier 0 items, whose pool IDs are determined by the reductions.
+			if outcome.Outcome.Int64() == 1 {
+				reductions := Reduce(entropy)
+				if poolID := 4*reductions.TerrainType + reductions.ItemType; outcome.Reward.Int64() != poolID {
+					t.Errorf("%s in block %s: contract rewards pool %s, reductions give pool %d", player.Address.Hex(), lastRollBlock.String(), outcome.Reward.String(), poolID)
+				}
+				items++
+			}
+		}
+	}
+
+	if items == 0 {
+		t.Errorf("expected some rolls to award items")
+	}
+}
@@ -0,0 +1,343 @@
+package index
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	_ "modernc.org/sqlite"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction"
+)
+
+var ErrContractMismatch error = errors.New("the database already indexes a different JackpotJunction contract")
+var ErrValueOutOfRange error = errors.New("event field does not fit in a SQLite integer")
+
+// Schema for the index. Token amounts (wei and ERC1155 balances) and pool IDs are stored as decimal strings since
+// they are uint256 values on the contract and do not fit in SQLite integers (any account can emit transfers of
+// arbitrary IDs through the contract). Outcomes, item types, terrain types, and tiers only take values chosen by
+// the contract, so they are stored as integers.
+//
+// There is no event for crafting, so crafts are reconstructed in the crafts view: a craft is a transaction in
+// which a player burns 2n items of a pool and is minted n items of the pool 1 tier higher. Only pool IDs which
+// fit in SQLite integers are considered.
+var Schema string = `
+CREATE TABLE IF NOT EXISTS state (
+	key TEXT PRIMARY KEY,
+	value TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS blocks (
+	number INTEGER PRIMARY KEY,
+	hash TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS rolls (
+	block_number INTEGER NOT NULL,
+	log_index INTEGER NOT NULL,
+	transaction_hash TEXT NOT NULL,
+	player TEXT NOT NULL,
+	PRIMARY KEY (block_number, log_index)
+);
+
+CREATE TABLE IF NOT EXISTS awards (
+	block_number INTEGER NOT NULL,
+	log_index INTEGER NOT NULL,
+	transaction_hash TEXT NOT NULL,
+	player TEXT NOT NULL,
+	outcome INTEGER NOT NULL,
+	value TEXT NOT NULL,
+	PRIMARY KEY (block_number, log_index)
+);
+
+CREATE TABLE IF NOT EXISTS tier_unlocks (
+	block_number INTEGER NOT NULL,
+	log_index INTEGER NOT NULL,
+	transaction_hash TEXT NOT NULL,
+	item_type INTEGER NOT NULL,
+	terrain_type INTEGER NOT NULL,
+	tier INTEGER NOT NULL,
+	pool_id TEXT NOT NULL,
+	PRIMARY KEY (block_number, log_index)
+);
+
+CREATE TABLE IF NOT EXISTS transfers (
+	block_number INTEGER NOT NULL,
+	log_index INTEGER NOT NULL,
+	batch_index INTEGER NOT NULL,
+	transaction_hash TEXT NOT NULL,
+	operator TEXT NOT NULL,
+	from_address TEXT NOT NULL,
+	to_address TEXT NOT NULL,
+	pool_id TEXT NOT NULL,
+	value TEXT NOT NULL,
+	PRIMARY KEY (block_number, log_index, batch_index)
+);
+
+CREATE TABLE IF NOT EXISTS approvals (
+	block_number INTEGER NOT NULL,
+	log_index INTEGER NOT NULL,
+	transaction_hash TEXT NOT NULL,
+	account TEXT NOT NULL,
+	operator TEXT NOT NULL,
+	approved INTEGER NOT NULL,
+	PRIMARY KEY (block_number, log_index)
+);
+
+CREATE TABLE IF NOT EXISTS uris (
+	block_number INTEGER NOT NULL,
+	log_index INTEGER NOT NULL,
+	transaction_hash TEXT NOT NULL,
+	pool_id TEXT NOT NULL,
+	value TEXT NOT NULL,
+	PRIMARY KEY (block_number, log_index)
+);
+
+CREATE INDEX IF NOT EXISTS rolls_player ON rolls (player);
+CREATE INDEX IF NOT EXISTS awards_player ON awards (player);
+CREATE INDEX IF NOT EXISTS transfers_transaction_hash ON transfers (transaction_hash);
+
+CREATE VIEW IF NOT EXISTS crafts AS
+SELECT
+	burns.block_number AS block_number,
+	burns.transaction_hash AS transaction_hash,
+	burns.from_address AS player,
+	CAST(burns.pool_id AS INTEGER) AS input_pool_id,
+	CAST(mints.pool_id AS INTEGER) AS output_pool_id,
+	mints.value AS num_outputs
+FROM transfers AS burns
+INNER JOIN transfers AS mints
+	ON burns.transaction_hash = mints.transaction_hash
+	AND mints.to_address = burns.from_address
+	AND CAST(mints.pool_id AS INTEGER) = CAST(burns.pool_id AS INTEGER) + 28
+WHERE burns.to_address = '0x0000000000000000000000000000000000000000'
+	AND CAST(CAST(burns.pool_id AS INTEGER) AS TEXT) = burns.pool_id
+	AND CAST(CAST(mints.pool_id AS INTEGER) AS TEXT) = mints.pool_id
+	AND mints.from_address = '0x0000000000000000000000000000000000000000'
+	AND CAST(burns.value AS INTEGER) = 2 * CAST(mints.value AS INTEGER);
+`
+
+// Open opens (or creates) the SQLite database at the given path and creates the index schema in it.
+func Open(path string) (*sql.DB, error) {
+	db, openErr := sql.Open("sqlite", path)
+	if openErr != nil {
+		return nil, openErr
+	}
+
+	// SQLite only supports a single writer.
+	db.SetMaxOpenConns(1)
+
+	_, schemaErr := db.Exec(Schema)
+	if schemaErr != nil {
+		db.Close()
+		return nil, schemaErr
+	}
+
+	return db, nil
+}
+
+// Interface satisfied by both *sql.DB and *sql.Tx.
+type execer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+func getState(db execer, key string) (string, bool, error) {
+	var value string
+	err := db.QueryRow("SELECT value FROM state WHERE key = ?", key).Scan(&value)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", false, nil
+	}
+	return value, err == nil, err
+}
+
+func setState(db execer, key, value string) error {
+	_, err := db.Exec("INSERT INTO state (key, value) VALUES (?, ?) ON CONFLICT (key) DO UPDATE SET value = excluded.value", key, value)
+	return err
+}
+
+// CheckContract records the given contract address in the database if it is new, and returns
+// ErrContractMismatch if the database was created for a different contract.
+func CheckContract(db *sql.DB, contractAddress common.Address) error {
+	stored, ok, err := getState(db, "contract")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return setState(db, "contract", contractAddress.Hex())
+	}
+	if common.HexToAddress(stored) != contractAddress {
+		return ErrContractMismatch
+	}
+	return nil
+}
+
+// LastIndexedBlock returns the last block that was indexed into the database. The second return value is
+// false if nothing has been indexed yet.
+func LastIndexedBlock(db *sql.DB) (uint64, bool, error) {
+	stored, ok, err := getState(db, "last_block")
+	if err != nil || !ok {
+		return 0, ok, err
+	}
+	lastBlock, parseErr := strconv.ParseUint(stored, 10, 64)
+	return lastBlock, parseErr == nil, parseErr
+}
+
+// BlockHash returns the hash recorded for the given block number, if any.
+func BlockHash(db *sql.DB, number uint64) (common.Hash, bool, error) {
+	var hash string
+	err := db.QueryRow("SELECT hash FROM blocks WHERE number = ?", number).Scan(&hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return common.Hash{}, false, nil
+	} else if err != nil {
+		return common.Hash{}, false, err
+	}
+	return common.HexToHash(hash), true, nil
+}
+
+// LatestBlockHash returns the number and hash of the latest block at or before the given block number that has
+// a hash recorded. The third return value is false if there is no such block.
+func LatestBlockHash(db *sql.DB, atMost uint64) (uint64, common.Hash, bool, error) {
+	var number uint64
+	var hash string
+	err := db.QueryRow("SELECT number, hash FROM blocks WHERE number <= ? ORDER BY number DESC LIMIT 1", atMost).Scan(&number, &hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, common.Hash{}, false, nil
+	} else if err != nil {
+		return 0, common.Hash{}, false, err
+	}
+	return number, common.HexToHash(hash), true, nil
+}
+
+// Rollback deletes all indexed data from blocks after the given block number, and marks the given block as
+// the last indexed block.
+func Rollback(db *sql.DB, lastValidBlock uint64) error {
+	tx, txErr := db.Begin()
+	if txErr != nil {
+		return txErr
+	}
+	defer tx.Rollback()
+
+	for _, table := range []string{"rolls", "awards", "tier_unlocks", "transfers", "approvals", "uris"} {
+		_, deleteErr := tx.Exec("DELETE FROM "+table+" WHERE block_number > ?", lastValidBlock)
+		if deleteErr != nil {
+			return deleteErr
+		}
+	}
+
+	_, deleteErr := tx.Exec("DELETE FROM blocks WHERE number > ?", lastValidBlock)
+	if deleteErr != nil {
+		return deleteErr
+	}
+
+	stateErr := setState(tx, "last_block", strconv.FormatUint(lastValidBlock, 10))
+	if stateErr != nil {
+		return stateErr
+	}
+
+	return tx.Commit()
+}
+
+// Stores the given decoded events (see JackpotJunction.DecodeLogs) along with the hashes of the blocks they
+// were emitted in.
+func storeEvents(db execer, events []interface{}) error {
+	for _, event := range events {
+		var storeErr error
+		var raw types.Log
+
+		switch e := event.(type) {
+		case *JackpotJunction.JackpotJunctionRoll:
+			raw = e.Raw
+			_, storeErr = db.Exec(
+				"INSERT OR REPLACE INTO rolls (block_number, log_index, transaction_hash, player) VALUES (?, ?, ?, ?)",
+				raw.BlockNumber, raw.Index, raw.TxHash.Hex(), e.Player.Hex(),
+			)
+		case *JackpotJunction.JackpotJunctionAward:
+			raw = e.Raw
+			outcome, outcomeErr := integer(e.Outcome)
+			if outcomeErr != nil {
+				return outcomeErr
+			}
+			_, storeErr = db.Exec(
+				"INSERT OR REPLACE INTO awards (block_number, log_index, transaction_hash, player, outcome, value) VALUES (?, ?, ?, ?, ?, ?)",
+				raw.BlockNumber, raw.Index, raw.TxHash.Hex(), e.Player.Hex(), outcome, e.Value.String(),
+			)
+		case *JackpotJunction.JackpotJunctionTierUnlocked:
+			raw = e.Raw
+			fields := make([]int64, 3)
+			for i, field := range []*big.Int{e.ItemType, e.TerrainType, e.Tier} {
+				var fieldErr error
+				fields[i], fieldErr = integer(field)
+				if fieldErr != nil {
+					return fieldErr
+				}
+			}
+			_, storeErr = db.Exec(
+				"INSERT OR REPLACE INTO tier_unlocks (block_number, log_index, transaction_hash, item_type, terrain_type, tier, pool_id) VALUES (?, ?, ?, ?, ?, ?, ?)",
+				raw.BlockNumber, raw.Index, raw.TxHash.Hex(), fields[0], fields[1], fields[2], e.PoolID.String(),
+			)
+		case *JackpotJunction.JackpotJunctionTransferSingle:
+			raw = e.Raw
+			storeErr = storeTransfer(db, raw, 0, e.Operator, e.From, e.To, e.Id, e.Value)
+		case *JackpotJunction.JackpotJunctionTransferBatch:
+			raw = e.Raw
+			for i := range e.Ids {
+				storeErr = storeTransfer(db, raw, i, e.Operator, e.From, e.To, e.Ids[i], e.Values[i])
+				if storeErr != nil {
+					break
+				}
+			}
+		case *JackpotJunction.JackpotJunctionApprovalForAll:
+			raw = e.Raw
+			_, storeErr = db.Exec(
+				"INSERT OR REPLACE INTO approvals (block_number, log_index, transaction_hash, account, operator, approved) VALUES (?, ?, ?, ?, ?, ?)",
+				raw.BlockNumber, raw.Index, raw.TxHash.Hex(), e.Account.Hex(), e.Operator.Hex(), e.Approved,
+			)
+		case *JackpotJunction.JackpotJunctionURI:
+			raw = e.Raw
+			_, storeErr = db.Exec(
+				"INSERT OR REPLACE INTO uris (block_number, log_index, transaction_hash, pool_id, value) VALUES (?, ?, ?, ?, ?)",
+				raw.BlockNumber, raw.Index, raw.TxHash.Hex(), e.Id.String(), e.Value,
+			)
+		default:
+			continue
+		}
+
+		if storeErr != nil {
+			return storeErr
+		}
+
+		blockErr := storeBlock(db, raw.BlockNumber, raw.BlockHash)
+		if blockErr != nil {
+			return blockErr
+		}
+	}
+
+	return nil
+}
+
+// Converts a uint256 event field to an integer that can be stored in SQLite, returning ErrValueOutOfRange if it
+// does not fit.
+func integer(value *big.Int) (int64, error) {
+	if !value.IsInt64() {
+		return 0, fmt.Errorf("%w: %s", ErrValueOutOfRange, value.String())
+	}
+	return value.Int64(), nil
+}
+
+func storeTransfer(db execer, raw types.Log, batchIndex int, operator, from, to common.Address, poolID, value *big.Int) error {
+	_, err := db.Exec(
+		"INSERT OR REPLACE INTO transfers (block_number, log_index, batch_index, transaction_hash, operator, from_address, to_address, pool_id, value) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		raw.BlockNumber, raw.Index, batchIndex, raw.TxHash.Hex(), operator.Hex(), from.Hex(), to.Hex(), poolID.String(), value.String(),
+	)
+	return err
+}
+
+func storeBlock(db execer, number uint64, hash common.Hash) error {
+	_, err := db.Exec("INSERT OR REPLACE INTO blocks (number, hash) VALUES (?, ?)", number, hash.Hex())
+	return err
+}
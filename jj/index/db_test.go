@@ -0,0 +1,103 @@
+package index
+
+import (
+	"errors"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction"
+)
+
+func TestStoreLargePoolIDs(t *testing.T) {
+	db, openErr := Open(filepath.Join(t.TempDir(), "jj.db"))
+	if openErr != nil {
+		t.Fatalf("unexpected error: %s", openErr.Error())
+	}
+	defer db.Close()
+
+	player := common.HexToAddress("0xa11ce")
+	large := new(big.Int).Lsh(big.NewInt(1), 255)
+	largest := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	transfer := func(index uint, transaction int64, from, to common.Address, poolID *big.Int, value int64) *JackpotJunction.JackpotJunctionTransferSingle {
+		return &JackpotJunction.JackpotJunctionTransferSingle{
+			From: from, To: to, Id: poolID, Value: big.NewInt(value),
+			Raw: types.Log{BlockNumber: 1, Index: index, TxHash: common.BigToHash(big.NewInt(transaction))},
+		}
+	}
+
+	events := []interface{}{
+		// A craft of 1 tier 1 item from 2 tier 0 items.
+		transfer(0, 1, player, common.Address{}, big.NewInt(3), 2),
+		transfer(1, 1, common.Address{}, player, big.NewInt(31), 1),
+		// IDs which do not fit in SQLite integers, in the shape of a craft.
+		transfer(2, 2, player, common.Address{}, large, 2),
+		transfer(3, 2, common.Address{}, player, new(big.Int).Add(large, big.NewInt(28)), 1),
+		transfer(4, 3, player, player, largest, 1),
+		&JackpotJunction.JackpotJunctionURI{Id: largest, Value: "ipfs://", Raw: types.Log{BlockNumber: 1, Index: 5}},
+		&JackpotJunction.JackpotJunctionTierUnlocked{
+			ItemType: big.NewInt(3), TerrainType: big.NewInt(0), Tier: big.NewInt(1), PoolID: big.NewInt(31),
+			Raw: types.Log{BlockNumber: 1, Index: 6},
+		},
+	}
+	if storeErr := storeEvents(db, events); storeErr != nil {
+		t.Fatalf("unexpected error: %s", storeErr.Error())
+	}
+
+	cases := []struct {
+		query    string
+		expected string
+	}{
+		{"SELECT pool_id FROM transfers WHERE log_index = 2", large.String()},
+		{"SELECT pool_id FROM transfers WHERE log_index = 4", largest.String()},
+		{"SELECT pool_id FROM uris", largest.String()},
+		{"SELECT pool_id FROM tier_unlocks", "31"},
+	}
+	for _, c := range cases {
+		var poolID string
+		if scanErr := db.QueryRow(c.query).Scan(&poolID); scanErr != nil {
+			t.Errorf("%s: unexpected error: %s", c.query, scanErr.Error())
+		} else if poolID != c.expected {
+			t.Errorf("%s: expected %s, got %s", c.query, c.expected, poolID)
+		}
+	}
+
+	rows, queryErr := db.Query("SELECT input_pool_id, output_pool_id, num_outputs FROM crafts")
+	if queryErr != nil {
+		t.Fatalf("unexpected error: %s", queryErr.Error())
+	}
+	defer rows.Close()
+	crafts := 0
+	for rows.Next() {
+		var input, output int64
+		var numOutputs string
+		if scanErr := rows.Scan(&input, &output, &numOutputs); scanErr != nil {
+			t.Fatalf("unexpected error: %s", scanErr.Error())
+		}
+		if input != 3 || output != 31 || numOutputs != "1" {
+			t.Errorf("expected a craft of pool 31 from pool 3, got %d from %d", output, input)
+		}
+		crafts++
+	}
+	if crafts != 1 {
+		t.Errorf("expected 1 craft, got %d", crafts)
+	}
+}
+
+func TestStoreOutOfRange(t *testing.T) {
+	db, openErr := Open(filepath.Join(t.TempDir(), "jj.db"))
+	if openErr != nil {
+		t.Fatalf("unexpected error: %s", openErr.Error())
+	}
+	defer db.Close()
+
+	events := []interface{}{
+		&JackpotJunction.JackpotJunctionAward{Outcome: new(big.Int).Lsh(big.NewInt(1), 64), Value: big.NewInt(1)},
+	}
+	if storeErr := storeEvents(db, events); !errors.Is(storeErr, ErrValueOutOfRange) {
+		t.Errorf("expected ErrValueOutOfRange, got %v", storeErr)
+	}
+}
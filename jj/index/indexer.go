@@ -0,0 +1,260 @@
+package index
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"strconv"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction"
+)
+
+// Backend is the subset of the Ethereum JSON-RPC API that the indexer needs. It is satisfied by
+// *ethclient.Client.
+type Backend interface {
+	ethereum.LogFilterer
+	BlockNumber(ctx context.Context) (uint64, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Indexer crawls the events emitted by a JackpotJunction contract into a database created with Open.
+type Indexer struct {
+	DB       *sql.DB
+	Backend  Backend
+	Contract common.Address
+	// Number of blocks to request logs for in a single eth_getLogs call.
+	ChunkSize uint64
+	// Minimum number of blocks to roll back when the indexer detects a reorg. The indexer rolls back further if
+	// the reorg reaches deeper than that.
+	ReorgDepth uint64
+	// Number of blocks behind the head of the chain to stay.
+	Confirmations uint64
+	// Timeout (in seconds) for each request to the JSON-RPC API.
+	Timeout uint
+	// Called after each chunk is indexed, with the range of blocks in the chunk and the number of events in it.
+	Progress func(fromBlock, toBlock uint64, numEvents int)
+	// Called when the indexer detects a reorg, with the block it rolled back to.
+	Reorg func(lastValidBlock uint64)
+}
+
+// Creates a new context to be used when interacting with the JSON-RPC API.
+func (indexer *Indexer) context(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, time.Duration(indexer.Timeout)*time.Second)
+}
+
+// Checks whether the indexed blocks are still part of the canonical chain, by comparing the latest recorded
+// block hash at or before the last indexed block with the canonical chain. Not every indexed block has a hash
+// recorded, so a block without one is covered by the latest recorded hash before it.
+//
+// If the hashes differ, the indexer walks back through the recorded hashes until it finds one that matches
+// the canonical chain, rolls back to that block (or ReorgDepth blocks, if that is further back), and returns
+// true. If no recorded hash matches, everything is rolled back.
+func (indexer *Indexer) checkReorg(ctx context.Context, lastBlock uint64) (bool, error) {
+	number, storedHash, ok, hashErr := LatestBlockHash(indexer.DB, lastBlock)
+	if hashErr != nil || !ok {
+		return false, hashErr
+	}
+
+	matches, matchesErr := indexer.canonical(ctx, number, storedHash)
+	if matchesErr != nil || matches {
+		return false, matchesErr
+	}
+
+	var lastValidBlock uint64
+	for number > 0 {
+		number, storedHash, ok, hashErr = LatestBlockHash(indexer.DB, number-1)
+		if hashErr != nil {
+			return false, hashErr
+		} else if !ok {
+			break
+		}
+
+		matches, matchesErr = indexer.canonical(ctx, number, storedHash)
+		if matchesErr != nil {
+			return false, matchesErr
+		} else if matches {
+			lastValidBlock = number
+			break
+		}
+	}
+
+	if lastBlock > indexer.ReorgDepth {
+		lastValidBlock = min(lastValidBlock, lastBlock-indexer.ReorgDepth)
+	} else {
+		lastValidBlock = 0
+	}
+
+	rollbackErr := Rollback(indexer.DB, lastValidBlock)
+	if rollbackErr != nil {
+		return false, rollbackErr
+	}
+
+	if indexer.Reorg != nil {
+		indexer.Reorg(lastValidBlock)
+	}
+
+	return true, nil
+}
+
+// Returns true if the block with the given number on the canonical chain has the given hash.
+func (indexer *Indexer) canonical(ctx context.Context, number uint64, hash common.Hash) (bool, error) {
+	headerCtx, cancel := indexer.context(ctx)
+	defer cancel()
+	header, headerErr := indexer.Backend.HeaderByNumber(headerCtx, new(big.Int).SetUint64(number))
+	if headerErr != nil {
+		return false, headerErr
+	}
+	return header.Hash() == hash, nil
+}
+
+// Indexes the events in the given (inclusive) range of blocks in a single database transaction.
+func (indexer *Indexer) indexChunk(ctx context.Context, fromBlock, toBlock uint64) (int, error) {
+	logsCtx, cancelLogs := indexer.context(ctx)
+	defer cancelLogs()
+	logs, logsErr := indexer.Backend.FilterLogs(logsCtx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		ToBlock:   new(big.Int).SetUint64(toBlock),
+		Addresses: []common.Address{indexer.Contract},
+	})
+	if logsErr != nil {
+		return 0, logsErr
+	}
+
+	logPointers := make([]*types.Log, len(logs))
+	for i := range logs {
+		logPointers[i] = &logs[i]
+	}
+
+	events, eventsErr := JackpotJunction.DecodeLogs(indexer.Contract, logPointers)
+	if eventsErr != nil {
+		return 0, eventsErr
+	}
+
+	// The hash of the last block in the chunk is recorded so that reorgs can be detected on the next
+	// iteration, even if the chunk contained no events.
+	headerCtx, cancelHeader := indexer.context(ctx)
+	defer cancelHeader()
+	header, headerErr := indexer.Backend.HeaderByNumber(headerCtx, new(big.Int).SetUint64(toBlock))
+	if headerErr != nil {
+		return 0, headerErr
+	}
+
+	tx, txErr := indexer.DB.Begin()
+	if txErr != nil {
+		return 0, txErr
+	}
+	defer tx.Rollback()
+
+	storeErr := storeEvents(tx, events)
+	if storeErr != nil {
+		return 0, storeErr
+	}
+
+	blockErr := storeBlock(tx, toBlock, header.Hash())
+	if blockErr != nil {
+		return 0, blockErr
+	}
+
+	stateErr := setState(tx, "last_block", strconv.FormatUint(toBlock, 10))
+	if stateErr != nil {
+		return 0, stateErr
+	}
+
+	return len(events), tx.Commit()
+}
+
+// Run indexes events from the given start block (or from the block after the last indexed block, if the
+// database already has data in it) up to the given end block. If end is 0, it indexes up to the current head
+// of the chain (less Confirmations). Run returns the last block that was indexed.
+func (indexer *Indexer) Run(ctx context.Context, start, end uint64) (uint64, error) {
+	checkErr := CheckContract(indexer.DB, indexer.Contract)
+	if checkErr != nil {
+		return 0, checkErr
+	}
+
+	chunkSize := indexer.ChunkSize
+	if chunkSize == 0 {
+		chunkSize = 1
+	}
+
+	for {
+		lastBlock, indexed, lastBlockErr := LastIndexedBlock(indexer.DB)
+		if lastBlockErr != nil {
+			return lastBlock, lastBlockErr
+		}
+
+		fromBlock := start
+		if indexed {
+			reorged, reorgErr := indexer.checkReorg(ctx, lastBlock)
+			if reorgErr != nil {
+				return lastBlock, reorgErr
+			} else if reorged {
+				continue
+			}
+
+			if lastBlock+1 > fromBlock {
+				fromBlock = lastBlock + 1
+			}
+		}
+
+		target := end
+		if target == 0 {
+			headCtx, cancel := indexer.context(ctx)
+			head, headErr := indexer.Backend.BlockNumber(headCtx)
+			cancel()
+			if headErr != nil {
+				return lastBlock, headErr
+			}
+			if head < indexer.Confirmations {
+				return lastBlock, nil
+			}
+			target = head - indexer.Confirmations
+		}
+
+		if fromBlock > target {
+			return lastBlock, nil
+		}
+
+		toBlock := fromBlock + chunkSize - 1
+		if toBlock > target {
+			toBlock = target
+		}
+
+		numEvents, chunkErr := indexer.indexChunk(ctx, fromBlock, toBlock)
+		if chunkErr != nil {
+			return lastBlock, chunkErr
+		}
+
+		if indexer.Progress != nil {
+			indexer.Progress(fromBlock, toBlock, numEvents)
+		}
+
+		select {
+		case <-ctx.Done():
+			return toBlock, ctx.Err()
+		default:
+		}
+	}
+}
+
+// Follow runs the indexer repeatedly, waiting for the given interval between runs, until the context is
+// cancelled.
+func (indexer *Indexer) Follow(ctx context.Context, start uint64, interval time.Duration) error {
+	for {
+		_, runErr := indexer.Run(ctx, start, 0)
+		if runErr != nil {
+			return runErr
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package index
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction"
+)
+
+var contractAddress common.Address = common.HexToAddress("0x5678")
+
+// Stands in for a JSON-RPC API serving a chain whose blocks from forkBlock onwards belong to the given fork. A
+// Roll event is emitted in each of the blocks in rolls.
+type chain struct {
+	head      uint64
+	forkBlock uint64
+	fork      string
+	rolls     []uint64
+}
+
+func (c *chain) header(number uint64) *types.Header {
+	header := &types.Header{Number: new(big.Int).SetUint64(number), Difficulty: big.NewInt(1)}
+	if number >= c.forkBlock {
+		header.Extra = []byte(c.fork)
+	}
+	return header
+}
+
+func (c *chain) BlockNumber(ctx context.Context) (uint64, error) {
+	return c.head, nil
+}
+
+func (c *chain) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return c.header(number.Uint64()), nil
+}
+
+func (c *chain) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	contractABI, _ := JackpotJunction.JackpotJunctionMetaData.GetAbi()
+	logs := []types.Log{}
+	for _, block := range c.rolls {
+		if block < query.FromBlock.Uint64() || block > query.ToBlock.Uint64() {
+			continue
+		}
+		logs = append(logs, types.Log{
+			Address:     contractAddress,
+			Topics:      []common.Hash{contractABI.Events["Roll"].ID, common.HexToHash("0x1234")},
+			BlockNumber: block,
+			BlockHash:   c.header(block).Hash(),
+		})
+	}
+	return logs, nil
+}
+
+func (c *chain) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, ethereum.NotFound
+}
+
+func indexedRolls(t *testing.T, indexer *Indexer) []uint64 {
+	rows, queryErr := indexer.DB.Query("SELECT block_number FROM rolls ORDER BY block_number")
+	if queryErr != nil {
+		t.Fatalf("could not query rolls: %s", queryErr.Error())
+	}
+	defer rows.Close()
+
+	blocks := []uint64{}
+	for rows.Next() {
+		var block uint64
+		rows.Scan(&block)
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
+func TestReorgDeeperThanReorgDepth(t *testing.T) {
+	db, openErr := Open(filepath.Join(t.TempDir(), "jj.db"))
+	if openErr != nil {
+		t.Fatalf("could not open database: %s", openErr.Error())
+	}
+	defer db.Close()
+
+	backend := &chain{head: 30, forkBlock: 31, fork: "a", rolls: []uint64{15}}
+	var rollbacks []uint64
+	indexer := &Indexer{
+		DB:         db,
+		Backend:    backend,
+		Contract:   contractAddress,
+		ChunkSize:  10,
+		ReorgDepth: 2,
+		Timeout:    10,
+		Reorg:      func(lastValidBlock uint64) { rollbacks = append(rollbacks, lastValidBlock) },
+	}
+
+	_, runErr := indexer.Run(context.Background(), 1, 0)
+	if runErr != nil {
+		t.Fatalf("unexpected error: %s", runErr.Error())
+	}
+
+	// The chain is replaced from block 12 onwards, which is further back than the last indexed block less
+	// ReorgDepth, and the roll moves from block 15 to block 16.
+	backend.forkBlock, backend.fork, backend.rolls = 12, "b", []uint64{16}
+	lastBlock, runErr := indexer.Run(context.Background(), 1, 0)
+	if runErr != nil {
+		t.Fatalf("unexpected error: %s", runErr.Error())
+	}
+
+	if len(rollbacks) != 1 || rollbacks[0] != 10 {
+		t.Errorf("expected a single rollback to block 10, the last block with a matching hash, got %v", rollbacks)
+	}
+	if lastBlock != 30 {
+		t.Errorf("expected to index up to block 30, got %d", lastBlock)
+	}
+	if rolls := indexedRolls(t, indexer); len(rolls) != 1 || rolls[0] != 16 {
+		t.Errorf("expected only the roll in block 16 to be indexed, got %v", rolls)
+	}
+}
+
+func TestReorgBelowBlockWithoutHash(t *testing.T) {
+	db, openErr := Open(filepath.Join(t.TempDir(), "jj.db"))
+	if openErr != nil {
+		t.Fatalf("could not open database: %s", openErr.Error())
+	}
+	defer db.Close()
+
+	backend := &chain{head: 30, forkBlock: 31, fork: "a"}
+	var rollbacks []uint64
+	indexer := &Indexer{
+		DB:         db,
+		Backend:    backend,
+		Contract:   contractAddress,
+		ChunkSize:  10,
+		ReorgDepth: 3,
+		Timeout:    10,
+		Reorg:      func(lastValidBlock uint64) { rollbacks = append(rollbacks, lastValidBlock) },
+	}
+
+	_, runErr := indexer.Run(context.Background(), 1, 0)
+	if runErr != nil {
+		t.Fatalf("unexpected error: %s", runErr.Error())
+	}
+
+	// After a rollback to block 25, which has no hash recorded, a reorg at block 15 must still be detected.
+	rollbackErr := Rollback(db, 25)
+	if rollbackErr != nil {
+		t.Fatalf("could not roll back: %s", rollbackErr.Error())
+	}
+	backend.forkBlock, backend.fork = 15, "b"
+
+	_, runErr = indexer.Run(context.Background(), 1, 0)
+	if runErr != nil {
+		t.Fatalf("unexpected error: %s", runErr.Error())
+	}
+	if len(rollbacks) != 1 || rollbacks[0] != 10 {
+		t.Errorf("expected a single rollback to block 10, got %v", rollbacks)
+	}
+
+	hash, ok, hashErr := BlockHash(db, 20)
+	if hashErr != nil || !ok || hash != backend.header(20).Hash() {
+		t.Errorf("expected block 20 to be indexed again from the new chain")
+	}
+}
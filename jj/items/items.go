@@ -0,0 +1,275 @@
+package items
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Kind is the item type of a Jackpot Junction item, i.e. the slot on a wagon that it can be equipped in.
+type Kind uint64
+
+// Terrain is the terrain type of a Jackpot Junction item.
+type Terrain uint64
+
+// Item types as encoded in JackpotJunction pool IDs.
+const (
+	Cover Kind = iota
+	Body
+	Wheels
+	Beasts
+)
+
+// Terrain types as encoded in JackpotJunction pool IDs.
+const (
+	Plains Terrain = iota
+	Forest
+	Swamp
+	Water
+	Mountain
+	Desert
+	Ice
+)
+
+// Number of item types, terrain types, and pool IDs in a single tier.
+const NumKinds uint64 = 4
+const NumTerrains uint64 = 7
+const PoolsPerTier uint64 = NumKinds * NumTerrains
+
+// Names of the item types and terrain types, as they appear in poolMetadata on the JackpotJunction contract.
+var KindNames []string = []string{"cover", "body", "wheels", "beasts"}
+var TerrainNames []string = []string{"plains", "forest", "swamp", "water", "mountain", "desert", "ice"}
+
+var ErrInvalidItem error = errors.New("invalid item -- expected a pool ID or a name of the form t<tier>-<terrain>-<kind> (e.g. t3-forest-wheels)")
+var ErrInvalidKind error = errors.New("invalid item type")
+var ErrInvalidTerrain error = errors.New("invalid terrain type")
+var ErrPoolIDTooLarge error = errors.New("pool ID is too large")
+
+// Item is a decoded JackpotJunction pool ID. Pool IDs are encoded as tier*28 + terrainType*4 + itemType.
+type Item struct {
+	Tier    uint64
+	Terrain Terrain
+	Kind    Kind
+}
+
+func (kind Kind) String() string {
+	if uint64(kind) >= NumKinds {
+		return strconv.FormatUint(uint64(kind), 10)
+	}
+	return KindNames[kind]
+}
+
+func (terrain Terrain) String() string {
+	if uint64(terrain) >= NumTerrains {
+		return strconv.FormatUint(uint64(terrain), 10)
+	}
+	return TerrainNames[terrain]
+}
+
+// ParseKind parses an item type from its name (e.g. "wheels").
+func ParseKind(name string) (Kind, error) {
+	for i, kindName := range KindNames {
+		if strings.EqualFold(name, kindName) {
+			return Kind(i), nil
+		}
+	}
+	return 0, ErrInvalidKind
+}
+
+// ParseTerrain parses a terrain type from its name (e.g. "forest").
+func ParseTerrain(name string) (Terrain, error) {
+	for i, terrainName := range TerrainNames {
+		if strings.EqualFold(name, terrainName) {
+			return Terrain(i), nil
+		}
+	}
+	return 0, ErrInvalidTerrain
+}
+
+// FromPoolID decodes a pool ID into an Item. This mirrors the genera method on the JackpotJunction contract.
+func FromPoolID(poolID uint64) Item {
+	return Item{
+		Tier:    poolID / PoolsPerTier,
+		Terrain: Terrain((poolID % PoolsPerTier) / NumKinds),
+		Kind:    Kind(poolID % NumKinds),
+	}
+}
+
+// FromBig decodes a pool ID represented as a *big.Int (as in the JackpotJunction bindings) into an Item.
+func FromBig(poolID *big.Int) (Item, error) {
+	if poolID.Sign() < 0 || !poolID.IsUint64() {
+		return Item{}, ErrPoolIDTooLarge
+	}
+	return FromPoolID(poolID.Uint64()), nil
+}
+
+// PoolID returns the ERC1155 pool ID of the item.
+func (item Item) PoolID() uint64 {
+	return item.Tier*PoolsPerTier + uint64(item.Terrain)*NumKinds + uint64(item.Kind)
+}
+
+// Big returns the ERC1155 pool ID of the item as a *big.Int, for use with the JackpotJunction bindings.
+func (item Item) Big() *big.Int {
+	return new(big.Int).SetUint64(item.PoolID())
+}
+
+// String returns the short name of the item, e.g. "t3-forest-wheels". Parse accepts this format.
+func (item Item) String() string {
+	return fmt.Sprintf("t%d-%s-%s", item.Tier, item.Terrain, item.Kind)
+}
+
+// Name returns the name of the item as it appears in poolMetadata on the JackpotJunction contract, e.g.
+// "Tier 3 forest wheels".
+func (item Item) Name() string {
+	return fmt.Sprintf("Tier %d %s %s", item.Tier, item.Terrain, item.Kind)
+}
+
+// Parse parses an item from either its short name (e.g. "t3-forest-wheels") or its pool ID (in decimal,
+// or in hexadecimal with a 0x prefix).
+func Parse(raw string) (Item, error) {
+	raw = strings.TrimSpace(raw)
+
+	poolID, ok := new(big.Int).SetString(raw, 0)
+	if ok {
+		return FromBig(poolID)
+	}
+
+	components := strings.Split(raw, "-")
+	if len(components) != 3 || len(components[0]) < 2 || (components[0][0] != 't' && components[0][0] != 'T') {
+		return Item{}, ErrInvalidItem
+	}
+
+	tier, tierErr := strconv.ParseUint(components[0][1:], 10, 64)
+	if tierErr != nil {
+		return Item{}, ErrInvalidItem
+	}
+
+	terrain, terrainErr := ParseTerrain(components[1])
+	if terrainErr != nil {
+		return Item{}, terrainErr
+	}
+
+	kind, kindErr := ParseKind(components[2])
+	if kindErr != nil {
+		return Item{}, kindErr
+	}
+
+	item := Item{Tier: tier, Terrain: terrain, Kind: kind}
+	if item.Tier > (^uint64(0)-(PoolsPerTier-1))/PoolsPerTier {
+		return Item{}, ErrPoolIDTooLarge
+	}
+
+	return item, nil
+}
+
+// ParsePoolID parses a pool ID from either an item name or a numeric pool ID (see Parse). Numeric pool IDs
+// are returned unchanged, even if they are too large to be decoded into an Item, since ERC1155 token IDs may
+// be any uint256.
+func ParsePoolID(raw string) (*big.Int, error) {
+	poolID, ok := new(big.Int).SetString(strings.TrimSpace(raw), 0)
+	if ok {
+		return poolID, nil
+	}
+
+	item, err := Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	return item.Big(), nil
+}
+
+func (item Item) MarshalText() ([]byte, error) {
+	return []byte(item.String()), nil
+}
+
+func (item *Item) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*item = parsed
+	return nil
+}
+
+// Attribute is a single attribute in the metadata returned by poolMetadata on the JackpotJunction contract.
+type Attribute struct {
+	TraitType   string `json:"trait_type"`
+	DisplayType string `json:"display_type"`
+	Value       string `json:"value"`
+}
+
+// Metadata is the structure of the JSON returned by poolMetadata on the JackpotJunction contract.
+type Metadata struct {
+	Name       string      `json:"name"`
+	Decimals   int         `json:"decimals"`
+	Attributes []Attribute `json:"attributes"`
+}
+
+// Metadata returns the metadata for the item, matching poolMetadata on the JackpotJunction contract.
+func (item Item) Metadata() Metadata {
+	return Metadata{
+		Name:     item.Name(),
+		Decimals: 0,
+		Attributes: []Attribute{
+			{TraitType: "tier", DisplayType: "number", Value: strconv.FormatUint(item.Tier, 10)},
+			{TraitType: "terrain_type", DisplayType: "string", Value: item.Terrain.String()},
+			{TraitType: "item_type", DisplayType: "string", Value: item.Kind.String()},
+		},
+	}
+}
+
+// MarshalJSON marshals the item into the same JSON structure that poolMetadata on the JackpotJunction
+// contract returns.
+func (item Item) MarshalJSON() ([]byte, error) {
+	return json.Marshal(item.Metadata())
+}
+
+// UnmarshalJSON accepts poolMetadata JSON objects, item names (as JSON strings), and pool IDs (as JSON
+// numbers or strings).
+func (item *Item) UnmarshalJSON(data []byte) error {
+	trimmed := strings.TrimSpace(string(data))
+	if strings.HasPrefix(trimmed, "\"") {
+		var raw string
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return err
+		}
+		return item.UnmarshalText([]byte(raw))
+	} else if !strings.HasPrefix(trimmed, "{") {
+		return item.UnmarshalText([]byte(trimmed))
+	}
+
+	var metadata Metadata
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return err
+	}
+
+	parsed := Item{}
+	for _, attribute := range metadata.Attributes {
+		switch attribute.TraitType {
+		case "tier":
+			tier, tierErr := strconv.ParseUint(attribute.Value, 10, 64)
+			if tierErr != nil {
+				return ErrInvalidItem
+			}
+			parsed.Tier = tier
+		case "terrain_type":
+			terrain, terrainErr := ParseTerrain(attribute.Value)
+			if terrainErr != nil {
+				return terrainErr
+			}
+			parsed.Terrain = terrain
+		case "item_type":
+			kind, kindErr := ParseKind(attribute.Value)
+			if kindErr != nil {
+				return kindErr
+			}
+			parsed.Kind = kind
+		}
+	}
+
+	*item = parsed
+	return nil
+}
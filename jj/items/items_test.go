@@ -0,0 +1,98 @@
+package items
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestPoolIDs(t *testing.T) {
+	cases := []struct {
+		poolID uint64
+		item   Item
+		name   string
+	}{
+		{0, Item{Tier: 0, Terrain: Plains, Kind: Cover}, "t0-plains-cover"},
+		{6, Item{Tier: 0, Terrain: Forest, Kind: Wheels}, "t0-forest-wheels"},
+		{27, Item{Tier: 0, Terrain: Ice, Kind: Beasts}, "t0-ice-beasts"},
+		{90, Item{Tier: 3, Terrain: Forest, Kind: Wheels}, "t3-forest-wheels"},
+	}
+
+	for _, c := range cases {
+		if item := FromPoolID(c.poolID); item != c.item {
+			t.Errorf("pool ID %d: expected %+v, got %+v", c.poolID, c.item, item)
+		}
+		if poolID := c.item.PoolID(); poolID != c.poolID {
+			t.Errorf("%s: expected pool ID %d, got %d", c.name, c.poolID, poolID)
+		}
+		if name := c.item.String(); name != c.name {
+			t.Errorf("pool ID %d: expected name %s, got %s", c.poolID, c.name, name)
+		}
+
+		for _, raw := range []string{c.name, big.NewInt(int64(c.poolID)).String(), "0x" + big.NewInt(int64(c.poolID)).Text(16)} {
+			parsed, parseErr := Parse(raw)
+			if parseErr != nil {
+				t.Errorf("%s: unexpected error: %s", raw, parseErr.Error())
+			} else if parsed != c.item {
+				t.Errorf("%s: expected %+v, got %+v", raw, c.item, parsed)
+			}
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := map[string]error{
+		"t3-forest":        ErrInvalidItem,
+		"3-forest-wheels":  ErrInvalidItem,
+		"tx-forest-wheels": ErrInvalidItem,
+		"t3-lava-wheels":   ErrInvalidTerrain,
+		"t3-forest-sails":  ErrInvalidKind,
+		"-1":               ErrPoolIDTooLarge,
+	}
+	for raw, expected := range cases {
+		if _, err := Parse(raw); !errors.Is(err, expected) {
+			t.Errorf("%s: expected %v, got %v", raw, expected, err)
+		}
+	}
+
+	if _, err := FromBig(new(big.Int).Lsh(big.NewInt(1), 64)); !errors.Is(err, ErrPoolIDTooLarge) {
+		t.Errorf("expected ErrPoolIDTooLarge for 2^64, got %v", err)
+	}
+}
+
+func TestParsePoolID(t *testing.T) {
+	large := new(big.Int).Lsh(big.NewInt(1), 200)
+	cases := map[string]*big.Int{
+		"t3-forest-wheels": big.NewInt(90),
+		"90":               big.NewInt(90),
+		large.String():     large,
+	}
+	for raw, expected := range cases {
+		poolID, parseErr := ParsePoolID(raw)
+		if parseErr != nil {
+			t.Errorf("%s: unexpected error: %s", raw, parseErr.Error())
+		} else if poolID.Cmp(expected) != 0 {
+			t.Errorf("%s: expected %s, got %s", raw, expected.String(), poolID.String())
+		}
+	}
+}
+
+func TestItemJSON(t *testing.T) {
+	item := Item{Tier: 3, Terrain: Forest, Kind: Wheels}
+	marshalled, marshalErr := json.Marshal(item)
+	if marshalErr != nil {
+		t.Fatalf("unexpected error: %s", marshalErr.Error())
+	}
+
+	var parsed []Item
+	unmarshalErr := json.Unmarshal([]byte(`[`+string(marshalled)+`, "t3-forest-wheels", 90, "90"]`), &parsed)
+	if unmarshalErr != nil {
+		t.Fatalf("unexpected error: %s", unmarshalErr.Error())
+	}
+	for i, p := range parsed {
+		if p != item {
+			t.Errorf("element %d: expected %+v, got %+v", i, item, p)
+		}
+	}
+}
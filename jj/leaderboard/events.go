@@ -0,0 +1,129 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction"
+)
+
+var ErrInvalidWindow error = errors.New("the end of the window must not be before its start")
+
+// Backend is the subset of the Ethereum JSON-RPC API that the leaderboards need. It is satisfied by
+// *ethclient.Client.
+type Backend interface {
+	ethereum.LogFilterer
+	BlockNumber(ctx context.Context) (uint64, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Creates a new context for a single request to the JSON-RPC API. If timeout is 0, the request is only bounded
+// by ctx.
+func requestContext(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout == 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// Build requests the logs emitted by the contract in the given (inclusive) range of blocks, chunkSize blocks
+// at a time, and builds leaderboards with the top limit entries from them. Each request is given at most
+// timeout to complete.
+func Build(ctx context.Context, backend Backend, contractAddress common.Address, fromBlock, toBlock, chunkSize uint64, limit int, timeout time.Duration) (Leaderboards, error) {
+	if toBlock < fromBlock {
+		return Leaderboards{}, ErrInvalidWindow
+	}
+	if chunkSize == 0 {
+		chunkSize = 1
+	}
+
+	builder := NewBuilder()
+	for chunkStart := fromBlock; chunkStart <= toBlock; chunkStart += chunkSize {
+		chunkEnd := chunkStart + chunkSize - 1
+		if chunkEnd > toBlock || chunkEnd < chunkStart {
+			chunkEnd = toBlock
+		}
+
+		logsCtx, cancel := requestContext(ctx, timeout)
+		logs, logsErr := backend.FilterLogs(logsCtx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(chunkStart),
+			ToBlock:   new(big.Int).SetUint64(chunkEnd),
+			Addresses: []common.Address{contractAddress},
+		})
+		cancel()
+		if logsErr != nil {
+			return Leaderboards{}, logsErr
+		}
+
+		logPointers := make([]*types.Log, len(logs))
+		for i := range logs {
+			logPointers[i] = &logs[i]
+		}
+
+		events, eventsErr := JackpotJunction.DecodeLogs(contractAddress, logPointers)
+		if eventsErr != nil {
+			return Leaderboards{}, eventsErr
+		}
+
+		for _, event := range events {
+			builder.Add(event)
+		}
+
+		if chunkEnd == toBlock {
+			break
+		}
+	}
+
+	return builder.Leaderboards(fromBlock, toBlock, limit), nil
+}
+
+// BlockAtTime returns the first block at or before the given head block whose timestamp is not earlier than
+// the given time. It uses a binary search over block headers, giving each request at most timeout to complete.
+func BlockAtTime(ctx context.Context, backend Backend, head uint64, t time.Time, timeout time.Duration) (uint64, error) {
+	target := uint64(t.Unix())
+	if t.Unix() < 0 {
+		target = 0
+	}
+
+	low, high := uint64(0), head
+	for low < high {
+		middle := low + (high-low)/2
+		headerCtx, cancel := requestContext(ctx, timeout)
+		header, headerErr := backend.HeaderByNumber(headerCtx, new(big.Int).SetUint64(middle))
+		cancel()
+		if headerErr != nil {
+			return 0, headerErr
+		}
+		if header.Time < target {
+			low = middle + 1
+		} else {
+			high = middle
+		}
+	}
+
+	return low, nil
+}
+
+// Window returns the range of blocks covering the last duration of time, ending at the current head of the
+// chain. Each request is given at most timeout to complete.
+func Window(ctx context.Context, backend Backend, duration, timeout time.Duration) (uint64, uint64, error) {
+	headCtx, cancel := requestContext(ctx, timeout)
+	head, headErr := backend.BlockNumber(headCtx)
+	cancel()
+	if headErr != nil {
+		return 0, 0, headErr
+	}
+
+	start, startErr := BlockAtTime(ctx, backend, head, time.Now().Add(-duration), timeout)
+	if startErr != nil {
+		return 0, 0, startErr
+	}
+
+	return start, head, nil
+}
@@ -0,0 +1,90 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// headerBackend serves headers with the given timestamps, and counts the headers it was asked for.
+type headerBackend struct {
+	times    []uint64
+	requests int
+}
+
+func (backend *headerBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return nil, nil
+}
+
+func (backend *headerBackend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, errors.New("not supported")
+}
+
+func (backend *headerBackend) BlockNumber(ctx context.Context) (uint64, error) {
+	return uint64(len(backend.times) - 1), nil
+}
+
+func (backend *headerBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	backend.requests++
+	if !number.IsUint64() || number.Uint64() >= uint64(len(backend.times)) {
+		return nil, ethereum.NotFound
+	}
+	return &types.Header{Number: number, Time: backend.times[number.Uint64()]}, nil
+}
+
+func TestBlockAtTime(t *testing.T) {
+	// Blocks 0 to 100, 12 seconds apart, with blocks 50 and 51 at the same time.
+	times := make([]uint64, 101)
+	for i := range times {
+		times[i] = 1000 + 12*uint64(i)
+	}
+	times[51] = times[50]
+
+	cases := []struct {
+		name     string
+		time     int64
+		head     uint64
+		expected uint64
+	}{
+		{"before the first block", 0, 100, 0},
+		{"before the epoch", -10, 100, 0},
+		{"first block", 1000, 100, 0},
+		{"exact timestamp", 1120, 100, 10},
+		{"between blocks", 1121, 100, 11},
+		{"first of blocks with the same timestamp", 1600, 100, 50},
+		{"head", 2200, 100, 100},
+		{"after the head", 5000, 100, 100},
+		{"after an earlier head", 5000, 20, 20},
+	}
+
+	for _, c := range cases {
+		backend := &headerBackend{times: times}
+		block, blockErr := BlockAtTime(context.Background(), backend, c.head, time.Unix(c.time, 0), 0)
+		if blockErr != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, blockErr.Error())
+			continue
+		}
+		if block != c.expected {
+			t.Errorf("%s: expected block %d, got %d", c.name, c.expected, block)
+		}
+		if backend.requests > 7 {
+			t.Errorf("%s: expected a binary search over 101 blocks to request at most 7 headers, got %d", c.name, backend.requests)
+		}
+	}
+
+	if _, blockErr := BlockAtTime(context.Background(), &headerBackend{times: times[:10]}, 100, time.Unix(2000, 0), 0); !errors.Is(blockErr, ethereum.NotFound) {
+		t.Errorf("expected the error from the backend, got %v", blockErr)
+	}
+}
+
+func TestBuildInvalidWindow(t *testing.T) {
+	backend := &headerBackend{}
+	if _, buildErr := Build(context.Background(), backend, alice, 10, 9, 100, 0, 0); !errors.Is(buildErr, ErrInvalidWindow) {
+		t.Errorf("expected ErrInvalidWindow, got %v", buildErr)
+	}
+}
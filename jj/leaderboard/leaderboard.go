@@ -0,0 +1,252 @@
+package leaderboard
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction"
+	"github.com/moonstream-to/degen-trail/jj/items"
+)
+
+// Names of the leaderboards, as used in the HTTP API.
+const (
+	Jackpots    string = "jackpots"
+	ItemsEarned string = "items"
+	HighestTier string = "tiers"
+	MostRolls   string = "rolls"
+)
+
+var Names []string = []string{Jackpots, ItemsEarned, HighestTier, MostRolls}
+
+// JackpotEntry is a single jackpot (outcome 4) that was awarded to a player.
+type JackpotEntry struct {
+	Rank            int            `json:"rank"`
+	Player          common.Address `json:"player"`
+	Value           *big.Int       `json:"value"`
+	BlockNumber     uint64         `json:"block_number"`
+	TransactionHash common.Hash    `json:"transaction_hash"`
+}
+
+// CountEntry ranks a player by a number of events (items earned, or rolls).
+type CountEntry struct {
+	Rank   int            `json:"rank"`
+	Player common.Address `json:"player"`
+	Count  uint64         `json:"count"`
+}
+
+// TierEntry ranks a player by the highest tier item they crafted. Unlocked is true if the player's craft of
+// that item unlocked the tier for its (item type, terrain type) pair (i.e. the contract emitted TierUnlocked).
+type TierEntry struct {
+	Rank            int            `json:"rank"`
+	Player          common.Address `json:"player"`
+	Tier            uint64         `json:"tier"`
+	PoolID          uint64         `json:"pool_id"`
+	Item            string         `json:"item"`
+	Unlocked        bool           `json:"unlocked"`
+	BlockNumber     uint64         `json:"block_number"`
+	TransactionHash common.Hash    `json:"transaction_hash"`
+}
+
+// Leaderboards is the set of leaderboards built from the events in a range of blocks.
+type Leaderboards struct {
+	FromBlock   uint64         `json:"from_block"`
+	ToBlock     uint64         `json:"to_block"`
+	Jackpots    []JackpotEntry `json:"jackpots"`
+	ItemsEarned []CountEntry   `json:"items"`
+	HighestTier []TierEntry    `json:"tiers"`
+	MostRolls   []CountEntry   `json:"rolls"`
+}
+
+// Get returns the leaderboard with the given name (one of Names), or nil if there is no such leaderboard.
+func (leaderboards Leaderboards) Get(name string) interface{} {
+	switch name {
+	case Jackpots:
+		return leaderboards.Jackpots
+	case ItemsEarned:
+		return leaderboards.ItemsEarned
+	case HighestTier:
+		return leaderboards.HighestTier
+	case MostRolls:
+		return leaderboards.MostRolls
+	}
+	return nil
+}
+
+// Builder accumulates JackpotJunction events (as decoded by JackpotJunction.DecodeLogs) into leaderboards.
+// Events must be added in the order in which they were emitted.
+type Builder struct {
+	jackpots    []JackpotEntry
+	itemsEarned map[common.Address]uint64
+	rolls       map[common.Address]uint64
+	highestTier map[common.Address]TierEntry
+
+	// Players whose highest tier was set by a craft in the transaction with hash craftsTransaction, by pool ID
+	// of the crafted item. TierUnlocked events are matched against these, so that they are attributed without
+	// scanning every player.
+	craftsTransaction common.Hash
+	crafts            map[uint64]common.Address
+}
+
+func NewBuilder() *Builder {
+	return &Builder{
+		jackpots:    []JackpotEntry{},
+		itemsEarned: make(map[common.Address]uint64),
+		rolls:       make(map[common.Address]uint64),
+		highestTier: make(map[common.Address]TierEntry),
+		crafts:      make(map[uint64]common.Address),
+	}
+}
+
+// Add adds a decoded event to the leaderboards. Events of types which do not affect the leaderboards are
+// ignored.
+func (builder *Builder) Add(event interface{}) {
+	switch e := event.(type) {
+	case *JackpotJunction.JackpotJunctionRoll:
+		builder.rolls[e.Player]++
+	case *JackpotJunction.JackpotJunctionAward:
+		if e.Outcome.Uint64() == 1 {
+			builder.itemsEarned[e.Player]++
+		} else if e.Outcome.Uint64() == 4 {
+			builder.jackpots = append(builder.jackpots, JackpotEntry{
+				Player:          e.Player,
+				Value:           e.Value,
+				BlockNumber:     e.Raw.BlockNumber,
+				TransactionHash: e.Raw.TxHash,
+			})
+		}
+	case *JackpotJunction.JackpotJunctionTransferSingle:
+		builder.addMint(e.From, e.To, e.Id, e.Raw.BlockNumber, e.Raw.TxHash)
+	case *JackpotJunction.JackpotJunctionTransferBatch:
+		for _, id := range e.Ids {
+			builder.addMint(e.From, e.To, id, e.Raw.BlockNumber, e.Raw.TxHash)
+		}
+	case *JackpotJunction.JackpotJunctionTierUnlocked:
+		if !e.PoolID.IsUint64() {
+			return
+		}
+		// The contract emits TierUnlocked after it mints the crafted items, in the same transaction.
+		if e.Raw.TxHash != builder.craftsTransaction {
+			return
+		}
+		poolID := e.PoolID.Uint64()
+		player, ok := builder.crafts[poolID]
+		if !ok {
+			return
+		}
+		entry := builder.highestTier[player]
+		if entry.TransactionHash == e.Raw.TxHash && entry.PoolID == poolID {
+			entry.Unlocked = true
+			builder.highestTier[player] = entry
+		}
+	}
+}
+
+// Records crafts. Items of tier 0 are only ever minted as awards, so any mint of an item of a higher tier is
+// the output of a craft.
+func (builder *Builder) addMint(from, to common.Address, id *big.Int, blockNumber uint64, transactionHash common.Hash) {
+	if from != (common.Address{}) || to == (common.Address{}) {
+		return
+	}
+
+	item, itemErr := items.FromBig(id)
+	if itemErr != nil || item.Tier == 0 {
+		return
+	}
+
+	current, ok := builder.highestTier[to]
+	if ok && current.Tier >= item.Tier {
+		return
+	}
+
+	if transactionHash != builder.craftsTransaction {
+		builder.craftsTransaction = transactionHash
+		clear(builder.crafts)
+	}
+	builder.crafts[item.PoolID()] = to
+
+	builder.highestTier[to] = TierEntry{
+		Player:          to,
+		Tier:            item.Tier,
+		PoolID:          item.PoolID(),
+		Item:            item.String(),
+		BlockNumber:     blockNumber,
+		TransactionHash: transactionHash,
+	}
+}
+
+// Leaderboards returns the leaderboards for the events added so far, truncated to the top limit entries. If
+// limit is 0, the leaderboards are not truncated.
+func (builder *Builder) Leaderboards(fromBlock, toBlock uint64, limit int) Leaderboards {
+	leaderboards := Leaderboards{
+		FromBlock:   fromBlock,
+		ToBlock:     toBlock,
+		Jackpots:    make([]JackpotEntry, len(builder.jackpots)),
+		ItemsEarned: countEntries(builder.itemsEarned),
+		HighestTier: make([]TierEntry, 0, len(builder.highestTier)),
+		MostRolls:   countEntries(builder.rolls),
+	}
+
+	copy(leaderboards.Jackpots, builder.jackpots)
+	sort.SliceStable(leaderboards.Jackpots, func(i, j int) bool {
+		return leaderboards.Jackpots[i].Value.Cmp(leaderboards.Jackpots[j].Value) > 0
+	})
+
+	for _, entry := range builder.highestTier {
+		leaderboards.HighestTier = append(leaderboards.HighestTier, entry)
+	}
+	// Ties are broken in favor of the player who reached the tier first.
+	sort.Slice(leaderboards.HighestTier, func(i, j int) bool {
+		a, b := leaderboards.HighestTier[i], leaderboards.HighestTier[j]
+		if a.Tier != b.Tier {
+			return a.Tier > b.Tier
+		}
+		if a.BlockNumber != b.BlockNumber {
+			return a.BlockNumber < b.BlockNumber
+		}
+		return bytes.Compare(a.Player[:], b.Player[:]) < 0
+	})
+
+	if limit > 0 {
+		if len(leaderboards.Jackpots) > limit {
+			leaderboards.Jackpots = leaderboards.Jackpots[:limit]
+		}
+		if len(leaderboards.ItemsEarned) > limit {
+			leaderboards.ItemsEarned = leaderboards.ItemsEarned[:limit]
+		}
+		if len(leaderboards.HighestTier) > limit {
+			leaderboards.HighestTier = leaderboards.HighestTier[:limit]
+		}
+		if len(leaderboards.MostRolls) > limit {
+			leaderboards.MostRolls = leaderboards.MostRolls[:limit]
+		}
+	}
+
+	for i := range leaderboards.Jackpots {
+		leaderboards.Jackpots[i].Rank = i + 1
+	}
+	for i := range leaderboards.HighestTier {
+		leaderboards.HighestTier[i].Rank = i + 1
+	}
+
+	return leaderboards
+}
+
+func countEntries(counts map[common.Address]uint64) []CountEntry {
+	entries := make([]CountEntry, 0, len(counts))
+	for player, count := range counts {
+		entries = append(entries, CountEntry{Player: player, Count: count})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Count != entries[j].Count {
+			return entries[i].Count > entries[j].Count
+		}
+		return bytes.Compare(entries[i].Player[:], entries[j].Player[:]) < 0
+	})
+	for i := range entries {
+		entries[i].Rank = i + 1
+	}
+	return entries
+}
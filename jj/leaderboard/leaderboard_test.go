@@ -0,0 +1,193 @@
+package leaderboard
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction"
+	"github.com/moonstream-to/degen-trail/jj/items"
+)
+
+var alice, bob, carol common.Address = common.HexToAddress("0xa11ce"), common.HexToAddress("0xb0b"), common.HexToAddress("0xca201")
+
+// Returns the raw log of an event emitted at the given block, in the transaction with the given (short) hash.
+func raw(blockNumber uint64, transaction int64) types.Log {
+	return types.Log{BlockNumber: blockNumber, TxHash: common.BigToHash(big.NewInt(transaction))}
+}
+
+func roll(player common.Address, blockNumber uint64) *JackpotJunction.JackpotJunctionRoll {
+	return &JackpotJunction.JackpotJunctionRoll{Player: player, Raw: raw(blockNumber, 0)}
+}
+
+func award(player common.Address, outcome, value int64, blockNumber uint64, transaction int64) *JackpotJunction.JackpotJunctionAward {
+	return &JackpotJunction.JackpotJunctionAward{Player: player, Outcome: big.NewInt(outcome), Value: big.NewInt(value), Raw: raw(blockNumber, transaction)}
+}
+
+// Returns the TransferSingle event for a mint of the given item to the player.
+func mint(player common.Address, item items.Item, blockNumber uint64, transaction int64) *JackpotJunction.JackpotJunctionTransferSingle {
+	return &JackpotJunction.JackpotJunctionTransferSingle{To: player, Id: item.Big(), Value: big.NewInt(1), Raw: raw(blockNumber, transaction)}
+}
+
+func unlocked(item items.Item, blockNumber uint64, transaction int64) *JackpotJunction.JackpotJunctionTierUnlocked {
+	return &JackpotJunction.JackpotJunctionTierUnlocked{
+		ItemType:    big.NewInt(int64(item.Kind)),
+		TerrainType: big.NewInt(int64(item.Terrain)),
+		Tier:        new(big.Int).SetUint64(item.Tier),
+		PoolID:      item.Big(),
+		Raw:         raw(blockNumber, transaction),
+	}
+}
+
+func build(events ...interface{}) Leaderboards {
+	builder := NewBuilder()
+	for _, event := range events {
+		builder.Add(event)
+	}
+	return builder.Leaderboards(0, 1000, 0)
+}
+
+func TestJackpots(t *testing.T) {
+	leaderboards := build(
+		award(alice, 4, 100, 10, 1),
+		award(bob, 4, 300, 11, 2),
+		award(carol, 3, 1000, 12, 3),
+		award(alice, 4, 300, 13, 4),
+		award(carol, 4, 200, 14, 5),
+	)
+
+	// Outcome 3 is not a jackpot, and equal jackpots are ranked in the order in which they were awarded.
+	expected := []JackpotEntry{
+		{1, bob, big.NewInt(300), 11, common.BigToHash(big.NewInt(2))},
+		{2, alice, big.NewInt(300), 13, common.BigToHash(big.NewInt(4))},
+		{3, carol, big.NewInt(200), 14, common.BigToHash(big.NewInt(5))},
+		{4, alice, big.NewInt(100), 10, common.BigToHash(big.NewInt(1))},
+	}
+	if len(leaderboards.Jackpots) != len(expected) {
+		t.Fatalf("expected %d jackpots, got %d: %+v", len(expected), len(leaderboards.Jackpots), leaderboards.Jackpots)
+	}
+	for i, entry := range leaderboards.Jackpots {
+		e := expected[i]
+		if entry.Rank != e.Rank || entry.Player != e.Player || entry.Value.Cmp(e.Value) != 0 || entry.BlockNumber != e.BlockNumber || entry.TransactionHash != e.TransactionHash {
+			t.Errorf("jackpot %d: expected %+v, got %+v", i, e, entry)
+		}
+	}
+}
+
+func TestCounts(t *testing.T) {
+	leaderboards := build(
+		roll(alice, 1), award(alice, 1, 3, 2, 1),
+		roll(bob, 3), award(bob, 0, 0, 4, 2),
+		roll(carol, 5), award(carol, 1, 7, 6, 3),
+		roll(alice, 7), award(alice, 2, 50, 8, 4),
+		roll(bob, 9), award(bob, 1, 0, 10, 5),
+		roll(bob, 11), award(bob, 1, 1, 12, 6),
+	)
+
+	cases := []struct {
+		name     string
+		entries  []CountEntry
+		expected []CountEntry
+	}{
+		{
+			// Ties are broken by address.
+			"most rolls",
+			leaderboards.MostRolls,
+			[]CountEntry{{1, bob, 3}, {2, alice, 2}, {3, carol, 1}},
+		},
+		{
+			// Only outcome 1 awards items.
+			"items earned",
+			leaderboards.ItemsEarned,
+			[]CountEntry{{1, bob, 2}, {2, alice, 1}, {3, carol, 1}},
+		},
+	}
+
+	for _, c := range cases {
+		if len(c.entries) != len(c.expected) {
+			t.Errorf("%s: expected %+v, got %+v", c.name, c.expected, c.entries)
+			continue
+		}
+		for i, entry := range c.entries {
+			if entry != c.expected[i] {
+				t.Errorf("%s: expected %+v at position %d, got %+v", c.name, c.expected[i], i, entry)
+			}
+		}
+	}
+}
+
+func TestHighestTier(t *testing.T) {
+	tier1 := items.Item{Tier: 1, Terrain: items.Forest, Kind: items.Wheels}
+	tier2 := items.Item{Tier: 2, Terrain: items.Forest, Kind: items.Wheels}
+	otherTier2 := items.Item{Tier: 2, Terrain: items.Swamp, Kind: items.Cover}
+
+	leaderboards := build(
+		// Awards mint tier 0 items, which do not count.
+		mint(carol, items.Item{Tier: 0, Terrain: items.Plains, Kind: items.Body}, 1, 1),
+		// Burns do not count either.
+		&JackpotJunction.JackpotJunctionTransferSingle{From: alice, Id: tier2.Big(), Value: big.NewInt(1), Raw: raw(2, 2)},
+		// alice unlocks tier 1, then crafts tier 2 without unlocking it.
+		mint(alice, tier1, 3, 3), unlocked(tier1, 3, 3),
+		mint(bob, tier2, 4, 4), unlocked(tier2, 4, 4),
+		mint(alice, tier2, 5, 5),
+		// A TierUnlocked event from another transaction is not attributed to alice's craft.
+		unlocked(tier2, 6, 6),
+		// carol reaches tier 2 later than bob, in a batch with a tier 1 item.
+		&JackpotJunction.JackpotJunctionTransferBatch{To: carol, Ids: []*big.Int{tier1.Big(), otherTier2.Big()}, Values: []*big.Int{big.NewInt(1), big.NewInt(1)}, Raw: raw(7, 7)},
+		unlocked(otherTier2, 7, 7),
+		// A lower tier craft does not replace the highest tier.
+		mint(bob, tier1, 8, 8),
+	)
+
+	expected := []TierEntry{
+		{1, bob, 2, tier2.PoolID(), tier2.String(), true, 4, common.BigToHash(big.NewInt(4))},
+		{2, alice, 2, tier2.PoolID(), tier2.String(), false, 5, common.BigToHash(big.NewInt(5))},
+		{3, carol, 2, otherTier2.PoolID(), otherTier2.String(), true, 7, common.BigToHash(big.NewInt(7))},
+	}
+	if len(leaderboards.HighestTier) != len(expected) {
+		t.Fatalf("expected %+v, got %+v", expected, leaderboards.HighestTier)
+	}
+	for i, entry := range leaderboards.HighestTier {
+		if entry != expected[i] {
+			t.Errorf("expected %+v at position %d, got %+v", expected[i], i, entry)
+		}
+	}
+}
+
+func TestLimit(t *testing.T) {
+	builder := NewBuilder()
+	for i, player := range []common.Address{alice, bob, carol} {
+		builder.Add(roll(player, uint64(i)))
+		builder.Add(award(player, 4, int64(i+1), uint64(i), int64(i)))
+	}
+
+	leaderboards := builder.Leaderboards(10, 20, 2)
+	if leaderboards.FromBlock != 10 || leaderboards.ToBlock != 20 {
+		t.Errorf("expected blocks 10 to 20, got %d to %d", leaderboards.FromBlock, leaderboards.ToBlock)
+	}
+	if len(leaderboards.Jackpots) != 2 || leaderboards.Jackpots[0].Player != carol || leaderboards.Jackpots[1].Rank != 2 {
+		t.Errorf("expected the top 2 jackpots, got %+v", leaderboards.Jackpots)
+	}
+	if len(leaderboards.MostRolls) != 2 {
+		t.Errorf("expected 2 entries for most rolls, got %d", len(leaderboards.MostRolls))
+	}
+
+	// The builder is not affected by the limit.
+	if all := builder.Leaderboards(10, 20, 0); len(all.Jackpots) != 3 || len(all.MostRolls) != 3 {
+		t.Errorf("expected all entries without a limit, got %+v", all)
+	}
+}
+
+func TestGet(t *testing.T) {
+	leaderboards := build(roll(alice, 1))
+	for _, name := range Names {
+		if leaderboards.Get(name) == nil {
+			t.Errorf("expected a leaderboard named %s", name)
+		}
+	}
+	if leaderboards.Get("jackpot") != nil {
+		t.Errorf("expected no leaderboard named jackpot")
+	}
+}
@@ -0,0 +1,57 @@
+package leaderboard
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Server serves the most recent leaderboards over HTTP as JSON:
+//
+//	GET /leaderboards         - all leaderboards
+//	GET /leaderboards/{name}  - a single leaderboard, where name is one of Names
+//
+// The leaderboards are replaced by calling Update.
+type Server struct {
+	mu           sync.RWMutex
+	leaderboards *Leaderboards
+}
+
+// Update replaces the leaderboards that the server serves.
+func (server *Server) Update(leaderboards Leaderboards) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	server.leaderboards = &leaderboards
+}
+
+func (server *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	server.mu.RLock()
+	leaderboards := server.leaderboards
+	server.mu.RUnlock()
+
+	if leaderboards == nil {
+		http.Error(w, "leaderboards are not ready yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	var response interface{}
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	if path == "/leaderboards" {
+		response = leaderboards
+	} else if name, ok := strings.CutPrefix(path, "/leaderboards/"); ok && leaderboards.Get(name) != nil {
+		response = leaderboards.Get(name)
+	} else {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
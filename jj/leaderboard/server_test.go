@@ -0,0 +1,78 @@
+package leaderboard
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestServer(t *testing.T) {
+	server := &Server{}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	get := func(method, path string) *http.Response {
+		t.Helper()
+		request, requestErr := http.NewRequest(method, httpServer.URL+path, nil)
+		if requestErr != nil {
+			t.Fatalf("unexpected error: %s", requestErr.Error())
+		}
+		response, responseErr := http.DefaultClient.Do(request)
+		if responseErr != nil {
+			t.Fatalf("unexpected error: %s", responseErr.Error())
+		}
+		return response
+	}
+
+	response := get(http.MethodGet, "/leaderboards")
+	response.Body.Close()
+	if response.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected status %d before the first update, got %d", http.StatusServiceUnavailable, response.StatusCode)
+	}
+
+	server.Update(build(roll(alice, 1), roll(bob, 2), roll(bob, 3), award(bob, 4, 500, 4, 1)))
+
+	response = get(http.MethodGet, "/leaderboards/")
+	var leaderboards Leaderboards
+	decodeErr := json.NewDecoder(response.Body).Decode(&leaderboards)
+	response.Body.Close()
+	if decodeErr != nil {
+		t.Fatalf("unexpected error: %s", decodeErr.Error())
+	}
+	if response.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("expected a JSON response, got %s", response.Header.Get("Content-Type"))
+	}
+	if leaderboards.ToBlock != 1000 || len(leaderboards.MostRolls) != 2 || len(leaderboards.Jackpots) != 1 || leaderboards.Jackpots[0].Value.Int64() != 500 {
+		t.Errorf("unexpected leaderboards: %+v", leaderboards)
+	}
+
+	response = get(http.MethodGet, "/leaderboards/"+MostRolls)
+	var rolls []CountEntry
+	decodeErr = json.NewDecoder(response.Body).Decode(&rolls)
+	response.Body.Close()
+	if decodeErr != nil {
+		t.Fatalf("unexpected error: %s", decodeErr.Error())
+	}
+	if len(rolls) != 2 || rolls[0] != (CountEntry{1, bob, 2}) || rolls[1] != (CountEntry{2, alice, 1}) {
+		t.Errorf("unexpected rolls leaderboard: %+v", rolls)
+	}
+
+	cases := []struct {
+		method string
+		path   string
+		status int
+	}{
+		{http.MethodHead, "/leaderboards/" + Jackpots, http.StatusOK},
+		{http.MethodGet, "/leaderboards/jackpot", http.StatusNotFound},
+		{http.MethodGet, "/", http.StatusNotFound},
+		{http.MethodPost, "/leaderboards", http.StatusMethodNotAllowed},
+	}
+	for _, c := range cases {
+		response := get(c.method, c.path)
+		response.Body.Close()
+		if response.StatusCode != c.status {
+			t.Errorf("%s %s: expected status %d, got %d", c.method, c.path, c.status, response.StatusCode)
+		}
+	}
+}
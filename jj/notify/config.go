@@ -0,0 +1,124 @@
+package notify
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+var ErrNoWebhooks error = errors.New("no webhooks configured")
+var ErrMissingURL error = errors.New("webhook is missing a url")
+var ErrUnknownKind error = errors.New("unknown notification kind: must be one of jackpot, tier_unlocked")
+
+// WebhookConfig configures a single webhook. Events lists the kinds of notifications that the webhook
+// receives (all kinds if it is empty). Templates overrides the default template for some kinds. A template
+// that starts with "@" is read from the file at the path following the "@", relative to the configuration
+// file.
+type WebhookConfig struct {
+	URL       string            `json:"url"`
+	Events    []string          `json:"events,omitempty"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	Templates map[string]string `json:"templates,omitempty"`
+}
+
+// Config is the format of the configuration file for the notifier:
+//
+//	{
+//	  "webhooks": [
+//	    {
+//	      "url": "https://discord.com/api/webhooks/...",
+//	      "events": ["jackpot", "tier_unlocked"],
+//	      "templates": {"jackpot": "@jackpot.json.tmpl"}
+//	    }
+//	  ]
+//	}
+type Config struct {
+	Webhooks []WebhookConfig `json:"webhooks"`
+}
+
+// LoadConfig reads the notifier configuration from a JSON file.
+func LoadConfig(path string) (Config, error) {
+	var config Config
+
+	contents, readErr := os.ReadFile(path)
+	if readErr != nil {
+		return config, readErr
+	}
+
+	unmarshalErr := json.Unmarshal(contents, &config)
+	return config, unmarshalErr
+}
+
+// Build parses the templates in the configuration. File references in templates are resolved relative
+// to baseDir.
+func (config Config) Build(baseDir string) ([]Webhook, error) {
+	if len(config.Webhooks) == 0 {
+		return nil, ErrNoWebhooks
+	}
+
+	webhooks := make([]Webhook, len(config.Webhooks))
+	for i, webhookConfig := range config.Webhooks {
+		if webhookConfig.URL == "" {
+			return nil, ErrMissingURL
+		}
+
+		kinds := webhookConfig.Events
+		if len(kinds) == 0 {
+			kinds = Kinds
+		}
+
+		for kind := range webhookConfig.Templates {
+			if !knownKind(kind) {
+				return nil, ErrUnknownKind
+			}
+		}
+
+		webhooks[i] = Webhook{
+			URL:       webhookConfig.URL,
+			Headers:   webhookConfig.Headers,
+			Templates: make(map[string]*template.Template),
+		}
+
+		for _, kind := range kinds {
+			if !knownKind(kind) {
+				return nil, ErrUnknownKind
+			}
+
+			text, ok := webhookConfig.Templates[kind]
+			if !ok {
+				text = DefaultTemplates[kind]
+			}
+			if strings.HasPrefix(text, "@") {
+				templatePath := text[1:]
+				if !filepath.IsAbs(templatePath) {
+					templatePath = filepath.Join(baseDir, templatePath)
+				}
+				contents, readErr := os.ReadFile(templatePath)
+				if readErr != nil {
+					return nil, readErr
+				}
+				text = string(contents)
+			}
+
+			tmpl, parseErr := ParseTemplate(kind, text)
+			if parseErr != nil {
+				return nil, parseErr
+			}
+			webhooks[i].Templates[kind] = tmpl
+		}
+	}
+
+	return webhooks, nil
+}
+
+func knownKind(kind string) bool {
+	for _, known := range Kinds {
+		if kind == known {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,280 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"text/template"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction"
+	"github.com/moonstream-to/degen-trail/jj/watch"
+)
+
+// Kinds of notifications.
+const (
+	KindJackpot      string = "jackpot"
+	KindTierUnlocked string = "tier_unlocked"
+)
+
+var Kinds []string = []string{KindJackpot, KindTierUnlocked}
+
+var ErrInvalidPayload error = errors.New("webhook template did not produce valid JSON")
+
+// Default templates produce payloads in the format accepted by Discord and Slack webhooks.
+var DefaultTemplates map[string]string = map[string]string{
+	KindJackpot:      `{"content": {{ printf "JACKPOT! %s won %s ETH (tx %s)" .Player.Hex .ValueEther .TransactionHash.Hex | json }}}`,
+	KindTierUnlocked: `{"content": {{ printf "New tier unlocked: %s (tier %s, tx %s)" .Item .Tier .TransactionHash.Hex | json }}}`,
+}
+
+// Notification is the data that webhook templates are rendered with.
+type Notification struct {
+	Kind            string
+	Contract        common.Address
+	BlockNumber     uint64
+	LogIndex        uint
+	TransactionHash common.Hash
+	// Set for jackpots.
+	Player     common.Address
+	Value      *big.Int
+	ValueEther string
+	// Set for tier unlocks.
+	ItemType    *big.Int
+	TerrainType *big.Int
+	Tier        *big.Int
+	PoolID      *big.Int
+	Item        string
+}
+
+// NewNotification returns the notification for the given event. The second return value is false if the
+// event should not be announced: only jackpots (Award events with outcome 4) and TierUnlocked events are
+// announced, and events removed by reorgs are never announced.
+func NewNotification(contractAddress common.Address, event watch.Event) (Notification, bool) {
+	notification := Notification{
+		Contract:        contractAddress,
+		BlockNumber:     event.BlockNumber,
+		LogIndex:        event.LogIndex,
+		TransactionHash: event.TransactionHash,
+	}
+
+	if event.Removed {
+		return notification, false
+	}
+
+	switch {
+	case event.Event == watch.EventAward && event.Outcome != nil && event.Outcome.Uint64() == 4:
+		notification.Kind = KindJackpot
+		notification.Player = *event.Player
+		notification.Value = event.Value
+		notification.ValueEther = JackpotJunction.FormatEther(event.Value)
+	case event.Event == watch.EventTierUnlocked:
+		notification.Kind = KindTierUnlocked
+		notification.ItemType = event.ItemType
+		notification.TerrainType = event.TerrainType
+		notification.Tier = event.Tier
+		notification.PoolID = event.PoolID
+		notification.Item = event.Item
+	default:
+		return notification, false
+	}
+
+	return notification, true
+}
+
+// Functions available in webhook templates, in addition to the text/template builtins:
+//
+//	json  - encodes its argument as JSON (use it to quote strings)
+//	ether - formats an amount in wei as ETH
+var templateFuncs template.FuncMap = template.FuncMap{
+	"json": func(value interface{}) (string, error) {
+		encoded, err := json.Marshal(value)
+		return string(encoded), err
+	},
+	"ether": JackpotJunction.FormatEther,
+}
+
+// ParseTemplate parses a webhook template.
+func ParseTemplate(name, text string) (*template.Template, error) {
+	return template.New(name).Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+}
+
+// Webhook is a URL that notifications are POSTed to. Templates maps each kind of notification that the
+// webhook receives to the template for its payload.
+type Webhook struct {
+	URL       string
+	Headers   map[string]string
+	Templates map[string]*template.Template
+}
+
+// Render renders the payload of the given notification for the webhook. The second return value is false if
+// the webhook does not receive notifications of that kind.
+func (webhook Webhook) Render(notification Notification) ([]byte, bool, error) {
+	tmpl, ok := webhook.Templates[notification.Kind]
+	if !ok {
+		return nil, false, nil
+	}
+
+	var payload bytes.Buffer
+	executeErr := tmpl.Execute(&payload, notification)
+	if executeErr != nil {
+		return nil, true, executeErr
+	}
+
+	if !json.Valid(payload.Bytes()) {
+		return nil, true, ErrInvalidPayload
+	}
+
+	return payload.Bytes(), true, nil
+}
+
+// DeliveryError is returned when a webhook responds with an unexpected status.
+type DeliveryError struct {
+	URL        string
+	StatusCode int
+	Body       string
+}
+
+func (e *DeliveryError) Error() string {
+	return fmt.Sprintf("webhook %s responded with status %d: %s", e.URL, e.StatusCode, e.Body)
+}
+
+// Requests which fail with these statuses (or without a response) are retried. Other errors are permanent.
+func retryable(err error) bool {
+	var deliveryErr *DeliveryError
+	if !errors.As(err, &deliveryErr) {
+		return true
+	}
+	return deliveryErr.StatusCode == http.StatusTooManyRequests || deliveryErr.StatusCode >= 500
+}
+
+// Notifier POSTs notifications for JackpotJunction events to webhooks. Its Handle method can be used as the
+// Handle function of a watch.Watcher.
+type Notifier struct {
+	Contract common.Address
+	Webhooks []Webhook
+	Client   *http.Client
+	// Notifications which were already delivered to a webhook are not delivered to it again. May be nil.
+	Seen *Seen
+	// Number of attempts to deliver each notification, and the delay before the first retry. The delay doubles
+	// after each retry.
+	MaxAttempts int
+	RetryDelay  time.Duration
+	// Called with the notification, the webhook, and the final error whenever a delivery fails. Failed
+	// deliveries do not stop the notifier.
+	Failed func(notification Notification, webhook Webhook, err error)
+	// Called whenever a notification is delivered.
+	Delivered func(notification Notification, webhook Webhook)
+}
+
+// Handle delivers the notification for the given event (if any) to every webhook that receives it. It only
+// returns an error if the deduplication state cannot be stored, or if ctx is cancelled while a notification is
+// being delivered.
+func (notifier *Notifier) Handle(ctx context.Context, event watch.Event) error {
+	notification, ok := NewNotification(notifier.Contract, event)
+	if !ok {
+		return nil
+	}
+
+	for _, webhook := range notifier.Webhooks {
+		key := dedupKey(webhook.URL, notification.TransactionHash, notification.LogIndex)
+		if notifier.Seen != nil && notifier.Seen.Contains(key) {
+			continue
+		}
+
+		payload, receives, renderErr := webhook.Render(notification)
+		if !receives {
+			continue
+		}
+
+		deliveryErr := renderErr
+		if deliveryErr == nil {
+			deliveryErr = notifier.deliver(ctx, webhook, payload)
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		} else if deliveryErr != nil {
+			if notifier.Failed != nil {
+				notifier.Failed(notification, webhook, deliveryErr)
+			}
+			continue
+		}
+
+		if notifier.Delivered != nil {
+			notifier.Delivered(notification, webhook)
+		}
+
+		if notifier.Seen != nil {
+			addErr := notifier.Seen.Add(key)
+			if addErr != nil {
+				return addErr
+			}
+		}
+	}
+
+	return nil
+}
+
+// POSTs the payload to the webhook, retrying failed requests with exponential backoff until ctx is cancelled.
+func (notifier *Notifier) deliver(ctx context.Context, webhook Webhook, payload []byte) error {
+	client := notifier.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	maxAttempts := notifier.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	delay := notifier.RetryDelay
+	var err error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		err = post(ctx, client, webhook, payload)
+		if err == nil || !retryable(err) {
+			return err
+		}
+
+		if attempt < maxAttempts {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+			delay *= 2
+		}
+	}
+
+	return err
+}
+
+func post(ctx context.Context, client *http.Client, webhook Webhook, payload []byte) error {
+	request, requestErr := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(payload))
+	if requestErr != nil {
+		return requestErr
+	}
+	request.Header.Set("Content-Type", "application/json")
+	for name, value := range webhook.Headers {
+		request.Header.Set(name, value)
+	}
+
+	response, responseErr := client.Do(request)
+	if responseErr != nil {
+		return responseErr
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, 512))
+		return &DeliveryError{URL: webhook.URL, StatusCode: response.StatusCode, Body: string(body)}
+	}
+
+	return nil
+}
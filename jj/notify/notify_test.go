@@ -0,0 +1,199 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/moonstream-to/degen-trail/jj/watch"
+)
+
+// Stands in for a webhook. It responds to the first failures requests with a 503, and records the payloads
+// of the requests that it accepts.
+type standIn struct {
+	mu       sync.Mutex
+	failures int
+	requests int
+	payloads []map[string]interface{}
+}
+
+func (s *standIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests++
+	if s.failures > 0 {
+		s.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	body, _ := io.ReadAll(r.Body)
+	var payload map[string]interface{}
+	json.Unmarshal(body, &payload)
+	s.payloads = append(s.payloads, payload)
+}
+
+func newNotifier(t *testing.T, url string, templates map[string]string) *Notifier {
+	config := Config{Webhooks: []WebhookConfig{{URL: url, Templates: templates}}}
+	webhooks, buildErr := config.Build(".")
+	if buildErr != nil {
+		t.Fatalf("could not build webhooks: %s", buildErr.Error())
+	}
+	return &Notifier{Webhooks: webhooks, Seen: NewSeen(), MaxAttempts: 3}
+}
+
+func jackpot(logIndex uint) watch.Event {
+	player := common.HexToAddress("0x1234")
+	return watch.Event{
+		Event:           watch.EventAward,
+		BlockNumber:     10,
+		LogIndex:        logIndex,
+		TransactionHash: common.HexToHash("0xabcd"),
+		Player:          &player,
+		Outcome:         big.NewInt(4),
+		Value:           big.NewInt(1500000000000000000),
+	}
+}
+
+func TestJackpotNotification(t *testing.T) {
+	server := &standIn{}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	notifier := newNotifier(t, httpServer.URL, map[string]string{
+		KindJackpot: `{"player": {{ .Player.Hex | json }}, "eth": {{ .ValueEther | json }}}`,
+	})
+
+	handleErr := notifier.Handle(context.Background(), jackpot(1))
+	if handleErr != nil {
+		t.Fatalf("unexpected error: %s", handleErr.Error())
+	}
+
+	if len(server.payloads) != 1 {
+		t.Fatalf("expected 1 payload, got %d", len(server.payloads))
+	}
+	if server.payloads[0]["player"] != common.HexToAddress("0x1234").Hex() {
+		t.Errorf("unexpected player in payload: %v", server.payloads[0]["player"])
+	}
+	if server.payloads[0]["eth"] != "1.5" {
+		t.Errorf("unexpected value in payload: %v", server.payloads[0]["eth"])
+	}
+}
+
+func TestDeduplication(t *testing.T) {
+	server := &standIn{}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	notifier := newNotifier(t, httpServer.URL, nil)
+
+	notifier.Handle(context.Background(), jackpot(1))
+	notifier.Handle(context.Background(), jackpot(1))
+	notifier.Handle(context.Background(), jackpot(2))
+
+	if len(server.payloads) != 2 {
+		t.Fatalf("expected 2 payloads, got %d", len(server.payloads))
+	}
+}
+
+func TestRetry(t *testing.T) {
+	server := &standIn{failures: 2}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	notifier := newNotifier(t, httpServer.URL, nil)
+	var failures int
+	notifier.Failed = func(notification Notification, webhook Webhook, err error) {
+		failures++
+	}
+
+	notifier.Handle(context.Background(), jackpot(1))
+
+	if server.requests != 3 || len(server.payloads) != 1 || failures != 0 {
+		t.Fatalf("expected delivery on the third attempt, got %d requests, %d payloads, %d failures", server.requests, len(server.payloads), failures)
+	}
+
+	server.failures = 3
+	notifier.Handle(context.Background(), jackpot(2))
+
+	if failures != 1 {
+		t.Fatalf("expected the delivery to fail after 3 attempts, got %d failures", failures)
+	}
+	if notifier.Seen.Contains(dedupKey(httpServer.URL, common.HexToHash("0xabcd"), 2)) {
+		t.Errorf("failed delivery should not be marked as seen")
+	}
+}
+
+func TestCancelDuringRetry(t *testing.T) {
+	server := &standIn{failures: 10}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	notifier := newNotifier(t, httpServer.URL, nil)
+	notifier.RetryDelay = time.Hour
+	var failures int
+	notifier.Failed = func(notification Notification, webhook Webhook, err error) {
+		failures++
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	started := time.Now()
+	handleErr := notifier.Handle(ctx, jackpot(1))
+
+	if !errors.Is(handleErr, context.DeadlineExceeded) {
+		t.Fatalf("expected the cancellation to be returned, got %v", handleErr)
+	}
+	if elapsed := time.Since(started); elapsed > 10*time.Second {
+		t.Errorf("expected the retry to be interrupted, but Handle took %s", elapsed)
+	}
+	if server.requests != 1 || failures != 0 {
+		t.Errorf("expected a single request and no reported failures, got %d requests and %d failures", server.requests, failures)
+	}
+}
+
+func TestIgnoredEvents(t *testing.T) {
+	server := &standIn{}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	notifier := newNotifier(t, httpServer.URL, nil)
+
+	smallReward := jackpot(1)
+	smallReward.Outcome = big.NewInt(2)
+	notifier.Handle(context.Background(), smallReward)
+
+	removed := jackpot(2)
+	removed.Removed = true
+	notifier.Handle(context.Background(), removed)
+
+	notifier.Handle(context.Background(), watch.Event{Event: watch.EventRoll})
+
+	if server.requests != 0 {
+		t.Fatalf("expected no requests, got %d", server.requests)
+	}
+
+	notifier.Handle(context.Background(), watch.Event{
+		Event:       watch.EventTierUnlocked,
+		LogIndex:    3,
+		ItemType:    big.NewInt(2),
+		TerrainType: big.NewInt(1),
+		Tier:        big.NewInt(3),
+		PoolID:      big.NewInt(90),
+		Item:        "t3-forest-wheels",
+	})
+
+	if len(server.payloads) != 1 {
+		t.Fatalf("expected 1 payload for the tier unlock, got %d", len(server.payloads))
+	}
+}
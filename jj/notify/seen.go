@@ -0,0 +1,92 @@
+package notify
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Seen is the set of notifications that were already delivered, keyed by webhook URL, transaction hash, and
+// log index. If it is backed by a file, each key is appended to the file as it is added so that the set
+// survives restarts.
+type Seen struct {
+	mu   sync.Mutex
+	keys map[string]bool
+	file *os.File
+}
+
+func dedupKey(url string, transactionHash common.Hash, logIndex uint) string {
+	return fmt.Sprintf("%s %s %d", url, transactionHash.Hex(), logIndex)
+}
+
+// NewSeen returns an empty set that is only kept in memory.
+func NewSeen() *Seen {
+	return &Seen{keys: make(map[string]bool)}
+}
+
+// OpenSeen loads the set from the given file (creating it if it does not exist) and appends new keys to it.
+func OpenSeen(path string) (*Seen, error) {
+	seen := NewSeen()
+
+	existing, openErr := os.Open(path)
+	if openErr == nil {
+		scanner := bufio.NewScanner(existing)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line != "" {
+				seen.keys[line] = true
+			}
+		}
+		existing.Close()
+		if scanner.Err() != nil {
+			return nil, scanner.Err()
+		}
+	} else if !errors.Is(openErr, os.ErrNotExist) {
+		return nil, openErr
+	}
+
+	file, fileErr := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if fileErr != nil {
+		return nil, fileErr
+	}
+	seen.file = file
+
+	return seen, nil
+}
+
+// Contains returns true if the key was already added to the set.
+func (seen *Seen) Contains(key string) bool {
+	seen.mu.Lock()
+	defer seen.mu.Unlock()
+	return seen.keys[key]
+}
+
+// Add adds a key to the set.
+func (seen *Seen) Add(key string) error {
+	seen.mu.Lock()
+	defer seen.mu.Unlock()
+
+	if seen.keys[key] {
+		return nil
+	}
+	seen.keys[key] = true
+
+	if seen.file != nil {
+		_, writeErr := seen.file.WriteString(key + "\n")
+		return writeErr
+	}
+	return nil
+}
+
+// Close closes the file backing the set, if any.
+func (seen *Seen) Close() error {
+	if seen.file == nil {
+		return nil
+	}
+	return seen.file.Close()
+}
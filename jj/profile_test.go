@@ -0,0 +1,76 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/moonstream-to/degen-trail/jj/config"
+)
+
+func TestApplyProfile(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	jjConfig := config.Config{
+		Default: "sepolia",
+		Profiles: map[string]config.Profile{
+			"sepolia": {RPC: "https://rpc.sepolia.org", Contract: "0x0000000000000000000000000000000000000abc", Timeout: 30},
+			"local":   {RPC: "http://127.0.0.1:8545", Timeout: 5},
+		},
+	}
+	if saveErr := config.Save(configPath, jjConfig); saveErr != nil {
+		t.Fatalf("unexpected error: %s", saveErr.Error())
+	}
+	t.Setenv(config.ConfigEnvVar, configPath)
+	t.Setenv(config.ProfileEnvVar, "")
+	t.Setenv("JACKPOT_JUNCTION_RPC_URL", "")
+
+	newCommand := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{Use: "test"}
+		cmd.Flags().String("rpc", "", "")
+		cmd.Flags().String("contract", "", "")
+		cmd.Flags().Uint("timeout", 60, "")
+		if parseErr := cmd.Flags().Parse(args); parseErr != nil {
+			t.Fatalf("unexpected error: %s", parseErr.Error())
+		}
+		return cmd
+	}
+
+	cases := []struct {
+		name     string
+		args     []string
+		profile  string
+		rpcEnv   string
+		rpc      string
+		contract string
+		timeout  string
+	}{
+		{"default profile", nil, "", "", "https://rpc.sepolia.org", "0x0000000000000000000000000000000000000abc", "30"},
+		{"named profile", nil, "local", "", "http://127.0.0.1:8545", "", "5"},
+		{"flags on the command line are kept", []string{"--rpc", "http://node:8545", "--timeout", "90"}, "", "", "http://node:8545", "0x0000000000000000000000000000000000000abc", "90"},
+		{"environment RPC URL is kept", nil, "", "http://env:8545", "", "0x0000000000000000000000000000000000000abc", "30"},
+	}
+
+	for _, c := range cases {
+		t.Setenv("JACKPOT_JUNCTION_RPC_URL", c.rpcEnv)
+		cmd := newCommand(c.args...)
+		if applyErr := ApplyProfile(cmd, c.profile); applyErr != nil {
+			t.Errorf("%s: unexpected error: %s", c.name, applyErr.Error())
+			continue
+		}
+
+		for flagName, expected := range map[string]string{"rpc": c.rpc, "contract": c.contract, "timeout": c.timeout} {
+			if value := cmd.Flags().Lookup(flagName).Value.String(); value != expected {
+				t.Errorf("%s: expected --%s to be %q, got %q", c.name, flagName, expected, value)
+			}
+		}
+		// Values from the profile behave like defaults, so they are not marked as changed.
+		if contractFlag := cmd.Flags().Lookup("contract"); contractFlag.Changed {
+			t.Errorf("%s: expected --contract not to be marked as changed", c.name)
+		}
+	}
+
+	if applyErr := ApplyProfile(newCommand(), "mainnet"); applyErr == nil {
+		t.Errorf("expected an error for a profile which does not exist")
+	}
+}
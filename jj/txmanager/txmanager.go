@@ -0,0 +1,553 @@
+package txmanager
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction"
+)
+
+var ErrUnknownAccount error = errors.New("account has not been added to the transaction manager")
+var ErrTooManyPending error = errors.New("account has too many pending transactions")
+var ErrReverted error = errors.New("transaction reverted")
+var ErrReplaced error = errors.New("nonce was used by a transaction which was not submitted through the transaction manager")
+var ErrDropped error = errors.New("transaction was dropped from the mempool and could not be rebroadcast")
+var ErrStopped error = errors.New("transaction manager stopped")
+
+// Gas limits used for roll and accept. Gas is not estimated for them, because estimates are made against the
+// latest block, which does not include the account's pending transactions.
+const DefaultRollGasLimit uint64 = 150000
+const DefaultAcceptGasLimit uint64 = 300000
+
+// Defaults for the Manager configuration.
+const DefaultBumpPercent uint64 = 15
+const DefaultStuckAfter time.Duration = 30 * time.Second
+const DefaultPollInterval time.Duration = 2 * time.Second
+const DefaultMaxPending int = 16
+const DefaultMaxRebroadcasts int = 5
+
+// Backend is the subset of the Ethereum JSON-RPC API that the transaction manager uses. It is satisfied by
+// *ethclient.Client.
+type Backend interface {
+	bind.ContractTransactor
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+	TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error)
+}
+
+// Request describes a call to a JackpotJunction method. If GasLimit is 0, the gas is estimated.
+type Request struct {
+	Method   string
+	Args     []interface{}
+	Value    *big.Int
+	GasLimit uint64
+}
+
+// Transaction is a transaction submitted through the manager. Its nonce is fixed, but the transaction that
+// is actually mined may be a replacement with higher fees.
+type Transaction struct {
+	Account common.Address
+	Nonce   uint64
+	Request Request
+
+	mu sync.Mutex
+	// Every signed version of the transaction which was sent, in order. The last one is the current version.
+	sent         []*types.Transaction
+	lastSent     time.Time
+	rebroadcasts int
+
+	done    chan struct{}
+	receipt *types.Receipt
+	err     error
+}
+
+// Hashes returns the hashes of all the versions of the transaction which were sent.
+func (transaction *Transaction) Hashes() []common.Hash {
+	transaction.mu.Lock()
+	defer transaction.mu.Unlock()
+	hashes := make([]common.Hash, len(transaction.sent))
+	for i, sent := range transaction.sent {
+		hashes[i] = sent.Hash()
+	}
+	return hashes
+}
+
+// Current returns the latest version of the transaction which was sent.
+func (transaction *Transaction) Current() *types.Transaction {
+	transaction.mu.Lock()
+	defer transaction.mu.Unlock()
+	return transaction.sent[len(transaction.sent)-1]
+}
+
+// Done returns a channel which is closed when the transaction is mined or fails.
+func (transaction *Transaction) Done() <-chan struct{} {
+	return transaction.done
+}
+
+// Wait waits until the transaction is mined and returns its receipt. If the transaction reverted, it
+// returns the receipt along with ErrReverted. Transactions which are replaced by transactions from outside
+// the manager fail with ErrReplaced, and transactions which are dropped fail with ErrDropped.
+func (transaction *Transaction) Wait(ctx context.Context) (*types.Receipt, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-transaction.done:
+		return transaction.receipt, transaction.err
+	}
+}
+
+func (transaction *Transaction) resolve(receipt *types.Receipt, err error) {
+	transaction.receipt = receipt
+	transaction.err = err
+	if err == nil && receipt != nil && receipt.Status != types.ReceiptStatusSuccessful {
+		transaction.err = ErrReverted
+	}
+	close(transaction.done)
+}
+
+type account struct {
+	mu     sync.Mutex
+	signer JackpotJunction.Signer
+	// Next nonce to assign. It is only valid if synced is true.
+	nextNonce uint64
+	synced    bool
+	// Pending transactions, in nonce order.
+	pending []*Transaction
+}
+
+// Manager submits JackpotJunction transactions for several accounts. It assigns nonces itself, so many
+// transactions can be pending for the same account at once (e.g. an accept and the roll that follows it).
+// Run must be running for the manager to track submitted transactions: it resolves mined transactions,
+// replaces stuck transactions with higher fees, and rebroadcasts dropped ones.
+//
+// The fields may be changed before Run is called.
+type Manager struct {
+	Backend  Backend
+	Contract common.Address
+	ChainID  *big.Int
+
+	// Percentage by which fees are raised when a stuck transaction is replaced. Nodes usually require at
+	// least 10%.
+	BumpPercent uint64
+	// A transaction which has not been mined this long after it was last sent is replaced.
+	StuckAfter time.Duration
+	// How fees are estimated for new transactions (see JackpotJunction.EstimateFees).
+	Fees JackpotJunction.FeeOptions
+	// Fees are never raised above this cap (in wei per gas). There is no cap if it is nil.
+	MaxFeePerGas *big.Int
+	// How often Run checks on pending transactions.
+	PollInterval time.Duration
+	// Maximum number of pending transactions per account.
+	MaxPending int
+	// Number of times a dropped transaction is rebroadcast before it fails with ErrDropped.
+	MaxRebroadcasts int
+	// Timeout for each interaction with the JSONRPC API.
+	Timeout time.Duration
+
+	// If Errored is not nil, it is called with errors that Run encounters while checking on transactions.
+	// Run retries on its next poll.
+	Errored func(err error)
+
+	abi      *abi.ABI
+	mu       sync.Mutex
+	accounts map[common.Address]*account
+}
+
+// NewManager creates a transaction manager for the JackpotJunction contract at the given address, with
+// the default configuration.
+func NewManager(backend Backend, contract common.Address, chainID *big.Int) (*Manager, error) {
+	contractABI, abiErr := JackpotJunction.JackpotJunctionMetaData.GetAbi()
+	if abiErr != nil {
+		return nil, abiErr
+	}
+
+	manager := &Manager{
+		Backend:         backend,
+		Contract:        contract,
+		ChainID:         chainID,
+		BumpPercent:     DefaultBumpPercent,
+		StuckAfter:      DefaultStuckAfter,
+		PollInterval:    DefaultPollInterval,
+		MaxPending:      DefaultMaxPending,
+		MaxRebroadcasts: DefaultMaxRebroadcasts,
+		Timeout:         30 * time.Second,
+		abi:             contractABI,
+		accounts:        map[common.Address]*account{},
+	}
+	return manager, nil
+}
+
+// AddAccount makes the manager submit transactions for the signer's account.
+func (manager *Manager) AddAccount(signer JackpotJunction.Signer) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+	if _, ok := manager.accounts[signer.Address()]; !ok {
+		manager.accounts[signer.Address()] = &account{signer: signer}
+	}
+}
+
+// Pending returns the number of transactions from the account which have not been resolved yet.
+func (manager *Manager) Pending(address common.Address) int {
+	manager.mu.Lock()
+	acct, ok := manager.accounts[address]
+	manager.mu.Unlock()
+	if !ok {
+		return 0
+	}
+
+	acct.mu.Lock()
+	defer acct.mu.Unlock()
+	return len(acct.pending)
+}
+
+// Roll submits a roll from the account. The value must be CostToRoll, or CostToReroll if the account is
+// rerolling.
+func (manager *Manager) Roll(ctx context.Context, address common.Address, value *big.Int) (*Transaction, error) {
+	return manager.Submit(ctx, address, Request{Method: "roll", Value: value, GasLimit: DefaultRollGasLimit})
+}
+
+// Accept submits an accept from the account. The contract only accepts an outcome in a later block than the
+// roll, so the roll should be mined (see Transaction.Wait) before the accept is submitted.
+func (manager *Manager) Accept(ctx context.Context, address common.Address) (*Transaction, error) {
+	return manager.Submit(ctx, address, Request{Method: "accept", GasLimit: DefaultAcceptGasLimit})
+}
+
+// Submit signs and sends a transaction from the account with the next nonce, without waiting for the
+// account's earlier transactions to be mined. Transactions from the same account are sent in the order in
+// which they are submitted.
+func (manager *Manager) Submit(ctx context.Context, address common.Address, request Request) (*Transaction, error) {
+	manager.mu.Lock()
+	acct, ok := manager.accounts[address]
+	manager.mu.Unlock()
+	if !ok {
+		return nil, ErrUnknownAccount
+	}
+
+	data, packErr := manager.abi.Pack(request.Method, request.Args...)
+	if packErr != nil {
+		return nil, packErr
+	}
+	value := request.Value
+	if value == nil {
+		value = new(big.Int)
+	}
+
+	acct.mu.Lock()
+	defer acct.mu.Unlock()
+
+	if manager.MaxPending > 0 && len(acct.pending) >= manager.MaxPending {
+		return nil, ErrTooManyPending
+	}
+
+	if !acct.synced {
+		nonceCtx, cancelNonceCtx := context.WithTimeout(ctx, manager.Timeout)
+		nonce, nonceErr := manager.Backend.PendingNonceAt(nonceCtx, address)
+		cancelNonceCtx()
+		if nonceErr != nil {
+			return nil, nonceErr
+		}
+		acct.nextNonce = nonce
+		acct.synced = true
+	}
+
+	gasLimit := request.GasLimit
+	if gasLimit == 0 {
+		estimateCtx, cancelEstimateCtx := context.WithTimeout(ctx, manager.Timeout)
+		estimate, estimateErr := manager.Backend.EstimateGas(estimateCtx, ethereum.CallMsg{From: address, To: &manager.Contract, Value: value, Data: data})
+		cancelEstimateCtx()
+		if estimateErr != nil {
+			return nil, JackpotJunction.DecodeError(estimateErr)
+		}
+		gasLimit = estimate
+	}
+
+	feesCtx, cancelFeesCtx := context.WithTimeout(ctx, manager.Timeout)
+	unsigned, feesErr := manager.newTransaction(feesCtx, acct.nextNonce, gasLimit, value, data)
+	cancelFeesCtx()
+	if feesErr != nil {
+		return nil, feesErr
+	}
+
+	transaction := &Transaction{
+		Account: address,
+		Nonce:   acct.nextNonce,
+		Request: request,
+		done:    make(chan struct{}),
+	}
+	sendErr := manager.send(ctx, acct.signer, transaction, unsigned)
+	if sendErr != nil {
+		// The nonce was not used, so the next transaction can take it. If the node has a different view of
+		// the nonce, we fetch it again.
+		acct.synced = len(acct.pending) > 0
+		return nil, sendErr
+	}
+
+	acct.nextNonce++
+	acct.pending = append(acct.pending, transaction)
+	return transaction, nil
+}
+
+// Builds an unsigned transaction to the contract with the fees estimated by JackpotJunction.EstimateFees,
+// capped at MaxFeePerGas. It returns a JackpotJunction.MaxSpendExceededError if the base fee is above
+// MaxFeePerGas.
+func (manager *Manager) newTransaction(ctx context.Context, nonce, gasLimit uint64, value *big.Int, data []byte) (types.TxData, error) {
+	fees, feesErr := JackpotJunction.EstimateFees(ctx, manager.Backend, manager.Fees)
+	if feesErr != nil {
+		return nil, feesErr
+	}
+
+	if manager.MaxFeePerGas != nil {
+		maxSpend := new(big.Int).Mul(manager.MaxFeePerGas, new(big.Int).SetUint64(gasLimit))
+		maxSpend.Add(maxSpend, value)
+		var capErr error
+		fees, capErr = fees.Cap(gasLimit, value, maxSpend)
+		if capErr != nil {
+			return nil, capErr
+		}
+	}
+
+	if fees.Legacy {
+		return &types.LegacyTx{Nonce: nonce, GasPrice: fees.GasPrice, Gas: gasLimit, To: &manager.Contract, Value: value, Data: data}, nil
+	}
+	return &types.DynamicFeeTx{ChainID: manager.ChainID, Nonce: nonce, GasTipCap: fees.MaxPriorityFeePerGas, GasFeeCap: fees.MaxFeePerGas, Gas: gasLimit, To: &manager.Contract, Value: value, Data: data}, nil
+}
+
+func (manager *Manager) capFee(fee *big.Int) *big.Int {
+	if manager.MaxFeePerGas != nil && fee.Cmp(manager.MaxFeePerGas) > 0 {
+		return new(big.Int).Set(manager.MaxFeePerGas)
+	}
+	return fee
+}
+
+// Signs and sends a version of the transaction.
+func (manager *Manager) send(ctx context.Context, signer JackpotJunction.Signer, transaction *Transaction, unsigned types.TxData) error {
+	signed, signErr := signer.SignTransaction(ctx, types.NewTx(unsigned), manager.ChainID)
+	if signErr != nil {
+		return signErr
+	}
+
+	sendCtx, cancelSendCtx := context.WithTimeout(ctx, manager.Timeout)
+	defer cancelSendCtx()
+	sendErr := manager.Backend.SendTransaction(sendCtx, signed)
+	if sendErr != nil {
+		return JackpotJunction.DecodeError(sendErr)
+	}
+
+	transaction.mu.Lock()
+	transaction.sent = append(transaction.sent, signed)
+	transaction.lastSent = time.Now()
+	transaction.mu.Unlock()
+	return nil
+}
+
+// Run tracks the transactions submitted through the manager until ctx is cancelled. When it returns, all
+// pending transactions fail with ErrStopped.
+func (manager *Manager) Run(ctx context.Context) error {
+	ticker := time.NewTicker(manager.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			manager.stop()
+			return ctx.Err()
+		case <-ticker.C:
+			manager.mu.Lock()
+			accounts := make([]*account, 0, len(manager.accounts))
+			for _, acct := range manager.accounts {
+				accounts = append(accounts, acct)
+			}
+			manager.mu.Unlock()
+
+			for _, acct := range accounts {
+				checkErr := manager.check(ctx, acct)
+				if checkErr != nil && ctx.Err() == nil && manager.Errored != nil {
+					manager.Errored(checkErr)
+				}
+			}
+		}
+	}
+}
+
+func (manager *Manager) stop() {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+	for _, acct := range manager.accounts {
+		acct.mu.Lock()
+		for _, transaction := range acct.pending {
+			transaction.resolve(nil, ErrStopped)
+		}
+		acct.pending = nil
+		acct.synced = false
+		acct.mu.Unlock()
+	}
+}
+
+// Checks on the pending transactions of an account: resolves the ones which were mined, replaces the
+// oldest one if it is stuck, and rebroadcasts it if it was dropped.
+func (manager *Manager) check(ctx context.Context, acct *account) error {
+	acct.mu.Lock()
+	defer acct.mu.Unlock()
+
+	if len(acct.pending) == 0 {
+		return nil
+	}
+
+	nonceCtx, cancelNonceCtx := context.WithTimeout(ctx, manager.Timeout)
+	minedNonce, nonceErr := manager.Backend.NonceAt(nonceCtx, acct.signer.Address(), nil)
+	cancelNonceCtx()
+	if nonceErr != nil {
+		return nonceErr
+	}
+
+	for len(acct.pending) > 0 && acct.pending[0].Nonce < minedNonce {
+		transaction := acct.pending[0]
+		receipt, receiptErr := manager.findReceipt(ctx, transaction)
+		if receiptErr != nil {
+			return receiptErr
+		}
+		if receipt == nil {
+			transaction.resolve(nil, ErrReplaced)
+		} else {
+			transaction.resolve(receipt, nil)
+		}
+		acct.pending = acct.pending[1:]
+	}
+
+	if len(acct.pending) == 0 {
+		return nil
+	}
+
+	// Only the oldest pending transaction can be stuck: the others are waiting for it.
+	oldest := acct.pending[0]
+	oldest.mu.Lock()
+	stuck := time.Since(oldest.lastSent) >= manager.StuckAfter
+	oldest.mu.Unlock()
+	if !stuck {
+		return nil
+	}
+
+	known, knownErr := manager.isKnown(ctx, oldest)
+	if knownErr != nil {
+		return knownErr
+	}
+
+	if known {
+		return manager.bump(ctx, acct, oldest)
+	}
+	return manager.rebroadcast(ctx, acct, oldest)
+}
+
+// Returns the receipt of whichever version of the transaction was mined, or nil if none of them was.
+func (manager *Manager) findReceipt(ctx context.Context, transaction *Transaction) (*types.Receipt, error) {
+	for _, hash := range transaction.Hashes() {
+		receiptCtx, cancelReceiptCtx := context.WithTimeout(ctx, manager.Timeout)
+		receipt, receiptErr := manager.Backend.TransactionReceipt(receiptCtx, hash)
+		cancelReceiptCtx()
+		if receiptErr == nil {
+			return receipt, nil
+		} else if !errors.Is(receiptErr, ethereum.NotFound) {
+			return nil, receiptErr
+		}
+	}
+	return nil, nil
+}
+
+// Returns true if the node knows about any version of the transaction.
+func (manager *Manager) isKnown(ctx context.Context, transaction *Transaction) (bool, error) {
+	for _, hash := range transaction.Hashes() {
+		lookupCtx, cancelLookupCtx := context.WithTimeout(ctx, manager.Timeout)
+		_, _, lookupErr := manager.Backend.TransactionByHash(lookupCtx, hash)
+		cancelLookupCtx()
+		if lookupErr == nil {
+			return true, nil
+		} else if !errors.Is(lookupErr, ethereum.NotFound) {
+			return false, lookupErr
+		}
+	}
+	return false, nil
+}
+
+// Replaces a stuck transaction with a version whose fees are raised by BumpPercent (or to the current
+// fees, if those are higher). If the fees are already at MaxFeePerGas, the transaction is left alone.
+func (manager *Manager) bump(ctx context.Context, acct *account, transaction *Transaction) error {
+	current := transaction.Current()
+
+	feesCtx, cancelFeesCtx := context.WithTimeout(ctx, manager.Timeout)
+	fresh, feesErr := manager.newTransaction(feesCtx, current.Nonce(), current.Gas(), current.Value(), current.Data())
+	cancelFeesCtx()
+	if feesErr != nil {
+		return feesErr
+	}
+
+	bumped := func(previous, suggested *big.Int) *big.Int {
+		raised := new(big.Int).Mul(previous, new(big.Int).SetUint64(100+manager.BumpPercent))
+		raised.Div(raised, big.NewInt(100))
+		if suggested.Cmp(raised) > 0 {
+			raised = new(big.Int).Set(suggested)
+		}
+		return manager.capFee(raised)
+	}
+
+	var replacement types.TxData
+	switch txData := fresh.(type) {
+	case *types.LegacyTx:
+		txData.GasPrice = bumped(current.GasPrice(), txData.GasPrice)
+		if txData.GasPrice.Cmp(current.GasPrice()) <= 0 {
+			return nil
+		}
+		replacement = txData
+	case *types.DynamicFeeTx:
+		txData.GasFeeCap = bumped(current.GasFeeCap(), txData.GasFeeCap)
+		txData.GasTipCap = bumped(current.GasTipCap(), txData.GasTipCap)
+		if txData.GasTipCap.Cmp(txData.GasFeeCap) > 0 {
+			txData.GasTipCap = new(big.Int).Set(txData.GasFeeCap)
+		}
+		if txData.GasFeeCap.Cmp(current.GasFeeCap()) <= 0 || txData.GasTipCap.Cmp(current.GasTipCap()) <= 0 {
+			return nil
+		}
+		replacement = txData
+	}
+
+	return manager.send(ctx, acct.signer, transaction, replacement)
+}
+
+// Sends the current version of a dropped transaction again. If that keeps failing, the transaction and all
+// the account's later transactions (which cannot be mined without it) fail with ErrDropped, and the account's
+// nonce is fetched from the node again on the next submission.
+func (manager *Manager) rebroadcast(ctx context.Context, acct *account, transaction *Transaction) error {
+	transaction.mu.Lock()
+	transaction.rebroadcasts++
+	exhausted := transaction.rebroadcasts > manager.MaxRebroadcasts
+	transaction.mu.Unlock()
+
+	if !exhausted {
+		sendCtx, cancelSendCtx := context.WithTimeout(ctx, manager.Timeout)
+		sendErr := manager.Backend.SendTransaction(sendCtx, transaction.Current())
+		cancelSendCtx()
+		if sendErr == nil {
+			transaction.mu.Lock()
+			transaction.lastSent = time.Now()
+			transaction.mu.Unlock()
+			return nil
+		}
+		return sendErr
+	}
+
+	for _, pending := range acct.pending {
+		pending.resolve(nil, ErrDropped)
+	}
+	acct.pending = nil
+	acct.synced = false
+	return nil
+}
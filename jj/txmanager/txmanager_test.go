@@ -0,0 +1,250 @@
+package txmanager
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction"
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction/jjtest"
+)
+
+// Starts a manager for the harness's first player. configure is called before Run starts.
+func startManager(t *testing.T, h *jjtest.Harness, backend Backend, configure func(manager *Manager)) *Manager {
+	manager, managerErr := NewManager(backend, h.Address, h.ChainID)
+	if managerErr != nil {
+		t.Fatalf("could not create manager: %s", managerErr.Error())
+	}
+	manager.PollInterval = 5 * time.Millisecond
+	manager.StuckAfter = time.Hour
+	manager.Errored = func(err error) { t.Logf("manager error: %s", err.Error()) }
+	if configure != nil {
+		configure(manager)
+	}
+	manager.AddAccount(JackpotJunction.NewPrivateKeySigner(h.Players[0].Key))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		manager.Run(ctx)
+		close(stopped)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-stopped
+	})
+	return manager
+}
+
+func wait(t *testing.T, transaction *Transaction) (*types.Receipt, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	receipt, waitErr := transaction.Wait(ctx)
+	if errors.Is(waitErr, context.DeadlineExceeded) {
+		t.Fatalf("transaction with nonce %d was not resolved", transaction.Nonce)
+	}
+	return receipt, waitErr
+}
+
+func TestPipelinedAcceptAndRoll(t *testing.T) {
+	h := jjtest.New(t, jjtest.Options{NumPlayers: 1})
+	player := h.Players[0]
+	manager := startManager(t, h, h.Client, nil)
+
+	_, rollErr := h.Roll(player)
+	if rollErr != nil {
+		t.Fatalf("could not roll: %s", rollErr.Error())
+	}
+	h.Mine(1)
+
+	// Accepting resets the player's last roll, so the roll after it costs CostToRoll.
+	accept, acceptErr := manager.Accept(context.Background(), player.Address)
+	if acceptErr != nil {
+		t.Fatalf("could not submit accept: %s", acceptErr.Error())
+	}
+	roll, rollErr := manager.Roll(context.Background(), player.Address, h.CostToRoll)
+	if rollErr != nil {
+		t.Fatalf("could not submit roll: %s", rollErr.Error())
+	}
+	if roll.Nonce != accept.Nonce+1 {
+		t.Errorf("expected the roll to take nonce %d, got %d", accept.Nonce+1, roll.Nonce)
+	}
+	if pending := manager.Pending(player.Address); pending != 2 {
+		t.Errorf("expected 2 pending transactions, got %d", pending)
+	}
+
+	h.Backend.Commit()
+
+	acceptReceipt, acceptWaitErr := wait(t, accept)
+	if acceptWaitErr != nil {
+		t.Fatalf("accept failed: %s", acceptWaitErr.Error())
+	}
+	rollReceipt, rollWaitErr := wait(t, roll)
+	if rollWaitErr != nil {
+		t.Fatalf("roll failed: %s", rollWaitErr.Error())
+	}
+	if acceptReceipt.BlockNumber.Cmp(rollReceipt.BlockNumber) != 0 {
+		t.Errorf("expected the accept and the roll in the same block, got blocks %s and %s", acceptReceipt.BlockNumber.String(), rollReceipt.BlockNumber.String())
+	}
+
+	awarded := false
+	for _, log := range acceptReceipt.Logs {
+		if _, parseErr := h.Contract.ParseAward(*log); parseErr == nil {
+			awarded = true
+		}
+	}
+	if !awarded {
+		t.Errorf("accept did not emit an Award event")
+	}
+
+	lastRollBlock, lastRollBlockErr := h.Contract.LastRollBlock(nil, player.Address)
+	if lastRollBlockErr != nil {
+		t.Fatalf("could not get LastRollBlock: %s", lastRollBlockErr.Error())
+	}
+	if lastRollBlock.Cmp(rollReceipt.BlockNumber) != 0 {
+		t.Errorf("expected the last roll in block %s, got %s", rollReceipt.BlockNumber.String(), lastRollBlock.String())
+	}
+	if pending := manager.Pending(player.Address); pending != 0 {
+		t.Errorf("expected no pending transactions, got %d", pending)
+	}
+}
+
+func TestStuckTransactionIsBumped(t *testing.T) {
+	h := jjtest.New(t, jjtest.Options{NumPlayers: 1})
+	player := h.Players[0]
+	manager := startManager(t, h, h.Client, func(manager *Manager) {
+		manager.StuckAfter = 20 * time.Millisecond
+	})
+
+	roll, rollErr := manager.Roll(context.Background(), player.Address, h.RollCost(player))
+	if rollErr != nil {
+		t.Fatalf("could not submit roll: %s", rollErr.Error())
+	}
+	original := roll.Current()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for len(roll.Hashes()) < 2 {
+		if time.Now().After(deadline) {
+			t.Fatalf("stuck transaction was not replaced")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	replacement := roll.Current()
+	if replacement.Nonce() != original.Nonce() {
+		t.Errorf("replacement has nonce %d instead of %d", replacement.Nonce(), original.Nonce())
+	}
+	if replacement.GasTipCap().Cmp(original.GasTipCap()) <= 0 || replacement.GasFeeCap().Cmp(original.GasFeeCap()) <= 0 {
+		t.Errorf("replacement fees (tip %s, cap %s) are not above the original fees (tip %s, cap %s)", replacement.GasTipCap().String(), replacement.GasFeeCap().String(), original.GasTipCap().String(), original.GasFeeCap().String())
+	}
+
+	h.Backend.Commit()
+
+	receipt, waitErr := wait(t, roll)
+	if waitErr != nil {
+		t.Fatalf("roll failed: %s", waitErr.Error())
+	}
+	if receipt.TxHash == original.Hash() {
+		t.Errorf("expected a replacement to be mined, got the original transaction")
+	}
+}
+
+func TestExternalNonceUse(t *testing.T) {
+	h := jjtest.New(t, jjtest.Options{NumPlayers: 1})
+	player := h.Players[0]
+	manager := startManager(t, h, h.Client, nil)
+
+	roll, rollErr := manager.Roll(context.Background(), player.Address, h.RollCost(player))
+	if rollErr != nil {
+		t.Fatalf("could not submit roll: %s", rollErr.Error())
+	}
+
+	// Another wallet holding the same key replaces the roll with a transfer.
+	current := roll.Current()
+	transfer := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   h.ChainID,
+		Nonce:     roll.Nonce,
+		GasTipCap: new(big.Int).Add(new(big.Int).Mul(current.GasTipCap(), big.NewInt(2)), big.NewInt(1)),
+		GasFeeCap: new(big.Int).Add(new(big.Int).Mul(current.GasFeeCap(), big.NewInt(2)), big.NewInt(1)),
+		Gas:       21000,
+		To:        &player.Address,
+		Value:     big.NewInt(1),
+	})
+	signed, signErr := types.SignTx(transfer, types.LatestSignerForChainID(h.ChainID), player.Key)
+	if signErr != nil {
+		t.Fatalf("could not sign transfer: %s", signErr.Error())
+	}
+	sendErr := h.Client.SendTransaction(context.Background(), signed)
+	if sendErr != nil {
+		t.Fatalf("could not send transfer: %s", sendErr.Error())
+	}
+	h.Backend.Commit()
+
+	if _, waitErr := wait(t, roll); !errors.Is(waitErr, ErrReplaced) {
+		t.Errorf("expected ErrReplaced, got %v", waitErr)
+	}
+}
+
+// Wraps a simulated chain and, while dropping is set, discards transactions instead of sending them.
+type droppingBackend struct {
+	simulated.Client
+	dropping atomic.Bool
+	sends    atomic.Int32
+}
+
+func (backend *droppingBackend) SendTransaction(ctx context.Context, transaction *types.Transaction) error {
+	backend.sends.Add(1)
+	if backend.dropping.Load() {
+		return nil
+	}
+	return backend.Client.SendTransaction(ctx, transaction)
+}
+
+func TestRebroadcastExhaustion(t *testing.T) {
+	h := jjtest.New(t, jjtest.Options{NumPlayers: 1})
+	player := h.Players[0]
+	backend := &droppingBackend{Client: h.Client}
+	manager := startManager(t, h, backend, func(manager *Manager) {
+		manager.StuckAfter = time.Millisecond
+		manager.MaxRebroadcasts = 2
+	})
+
+	backend.dropping.Store(true)
+	first, firstErr := manager.Roll(context.Background(), player.Address, h.RollCost(player))
+	if firstErr != nil {
+		t.Fatalf("could not submit roll: %s", firstErr.Error())
+	}
+	second, secondErr := manager.Roll(context.Background(), player.Address, h.CostToReroll)
+	if secondErr != nil {
+		t.Fatalf("could not submit reroll: %s", secondErr.Error())
+	}
+
+	for _, transaction := range []*Transaction{first, second} {
+		if _, waitErr := wait(t, transaction); !errors.Is(waitErr, ErrDropped) {
+			t.Errorf("nonce %d: expected ErrDropped, got %v", transaction.Nonce, waitErr)
+		}
+	}
+	if sends := backend.sends.Load(); sends != 4 {
+		t.Errorf("expected 2 sends and 2 rebroadcasts, got %d sends", sends)
+	}
+
+	// The dropped nonces are free again.
+	backend.dropping.Store(false)
+	retry, retryErr := manager.Roll(context.Background(), player.Address, h.RollCost(player))
+	if retryErr != nil {
+		t.Fatalf("could not submit roll: %s", retryErr.Error())
+	}
+	if retry.Nonce != first.Nonce {
+		t.Errorf("expected the retry to take nonce %d, got %d", first.Nonce, retry.Nonce)
+	}
+	h.Backend.Commit()
+
+	if _, waitErr := wait(t, retry); waitErr != nil {
+		t.Errorf("retry failed: %s", waitErr.Error())
+	}
+}
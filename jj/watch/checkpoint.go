@@ -0,0 +1,62 @@
+package watch
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+// Checkpoint is the position in the event stream from which a watcher resumes. All events before
+// (NextBlock, NextLogIndex) have been processed.
+type Checkpoint struct {
+	NextBlock    uint64 `json:"next_block"`
+	NextLogIndex uint   `json:"next_log_index"`
+}
+
+// Before returns true if the log at the given position comes before the checkpoint, i.e. if it has already
+// been processed.
+func (checkpoint Checkpoint) Before(blockNumber uint64, logIndex uint) bool {
+	return blockNumber < checkpoint.NextBlock || (blockNumber == checkpoint.NextBlock && logIndex < checkpoint.NextLogIndex)
+}
+
+// LoadCheckpoint reads a checkpoint from the given file. The second return value is false if the file does
+// not exist.
+func LoadCheckpoint(path string) (Checkpoint, bool, error) {
+	var checkpoint Checkpoint
+
+	contents, readErr := os.ReadFile(path)
+	if errors.Is(readErr, os.ErrNotExist) {
+		return checkpoint, false, nil
+	} else if readErr != nil {
+		return checkpoint, false, readErr
+	}
+
+	unmarshalErr := json.Unmarshal(contents, &checkpoint)
+	return checkpoint, unmarshalErr == nil, unmarshalErr
+}
+
+// SaveCheckpoint writes a checkpoint to the given file. It writes to a temporary file first and renames it
+// so that the checkpoint is never left half-written.
+func SaveCheckpoint(path string, checkpoint Checkpoint) error {
+	contents, marshalErr := json.Marshal(checkpoint)
+	if marshalErr != nil {
+		return marshalErr
+	}
+
+	tmp, tmpErr := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if tmpErr != nil {
+		return tmpErr
+	}
+	defer os.Remove(tmp.Name())
+
+	_, writeErr := tmp.Write(contents)
+	closeErr := tmp.Close()
+	if writeErr != nil {
+		return writeErr
+	} else if closeErr != nil {
+		return closeErr
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,428 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction"
+	"github.com/moonstream-to/degen-trail/jj/items"
+)
+
+// Names of the events that can be watched.
+const (
+	EventRoll         string = "Roll"
+	EventAward        string = "Award"
+	EventTierUnlocked string = "TierUnlocked"
+)
+
+var EventNames []string = []string{EventRoll, EventAward, EventTierUnlocked}
+
+var ErrUnknownEvent error = errors.New("unknown event: must be one of Roll, Award, TierUnlocked")
+
+// Filter selects the events to watch. Players applies to Roll and Award events, Outcomes applies to Award
+// events, and Tiers applies to TierUnlocked events. Empty fields do not filter anything.
+type Filter struct {
+	Events   []string
+	Players  []common.Address
+	Outcomes []*big.Int
+	Tiers    []*big.Int
+}
+
+// Validate checks that the filter only refers to known events.
+func (filter Filter) Validate() error {
+	for _, name := range filter.Events {
+		known := false
+		for _, eventName := range EventNames {
+			known = known || name == eventName
+		}
+		if !known {
+			return ErrUnknownEvent
+		}
+	}
+	return nil
+}
+
+func (filter Filter) includes(name string) bool {
+	if len(filter.Events) == 0 {
+		return true
+	}
+	for _, eventName := range filter.Events {
+		if eventName == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns true if the filter selects the given event.
+func (filter Filter) matches(e Event) bool {
+	if !filter.includes(e.Event) {
+		return false
+	}
+
+	if e.Player != nil && len(filter.Players) > 0 {
+		selected := false
+		for _, player := range filter.Players {
+			selected = selected || player == *e.Player
+		}
+		if !selected {
+			return false
+		}
+	}
+
+	var values []*big.Int
+	var value *big.Int
+	switch e.Event {
+	case EventAward:
+		values, value = filter.Outcomes, e.Outcome
+	case EventTierUnlocked:
+		values, value = filter.Tiers, e.Tier
+	}
+	if len(values) == 0 {
+		return true
+	}
+	for _, candidate := range values {
+		if candidate.Cmp(value) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Event is a decoded Roll, Award, or TierUnlocked event in a form suitable for JSON output. Removed is true
+// if the event was removed from the canonical chain by a reorg.
+type Event struct {
+	Event           string          `json:"event"`
+	BlockNumber     uint64          `json:"block_number"`
+	LogIndex        uint            `json:"log_index"`
+	TransactionHash common.Hash     `json:"transaction_hash"`
+	Removed         bool            `json:"removed"`
+	Player          *common.Address `json:"player,omitempty"`
+	Outcome         *big.Int        `json:"outcome,omitempty"`
+	Value           *big.Int        `json:"value,omitempty"`
+	ItemType        *big.Int        `json:"item_type,omitempty"`
+	TerrainType     *big.Int        `json:"terrain_type,omitempty"`
+	Tier            *big.Int        `json:"tier,omitempty"`
+	PoolID          *big.Int        `json:"pool_id,omitempty"`
+	// Item is set for item awards (outcome 1) and for TierUnlocked events.
+	Item string `json:"item,omitempty"`
+	// Decoded is the event as decoded by the JackpotJunction bindings.
+	Decoded interface{} `json:"-"`
+}
+
+// NewEvent converts an event decoded by the JackpotJunction bindings. The second return value is false if
+// the event is not a Roll, Award, or TierUnlocked event.
+func NewEvent(decoded interface{}) (Event, bool) {
+	var result Event
+	var raw types.Log
+
+	switch e := decoded.(type) {
+	case *JackpotJunction.JackpotJunctionRoll:
+		raw = e.Raw
+		result = Event{Event: EventRoll, Player: &e.Player}
+	case *JackpotJunction.JackpotJunctionAward:
+		raw = e.Raw
+		result = Event{Event: EventAward, Player: &e.Player, Outcome: e.Outcome, Value: e.Value}
+		if e.Outcome.Uint64() == 1 {
+			item, itemErr := items.FromBig(e.Value)
+			if itemErr == nil {
+				result.Item = item.String()
+			}
+		}
+	case *JackpotJunction.JackpotJunctionTierUnlocked:
+		raw = e.Raw
+		result = Event{Event: EventTierUnlocked, ItemType: e.ItemType, TerrainType: e.TerrainType, Tier: e.Tier, PoolID: e.PoolID}
+		item, itemErr := items.FromBig(e.PoolID)
+		if itemErr == nil {
+			result.Item = item.String()
+		}
+	default:
+		return result, false
+	}
+
+	result.BlockNumber = raw.BlockNumber
+	result.LogIndex = raw.Index
+	result.TransactionHash = raw.TxHash
+	result.Removed = raw.Removed
+	result.Decoded = decoded
+
+	return result, true
+}
+
+// Backend is the subset of the Ethereum JSON-RPC API that the watcher needs. It is satisfied by
+// *ethclient.Client. Subscriptions are only supported over websocket (and IPC) connections.
+type Backend interface {
+	bind.ContractFilterer
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// Errors returned by Handle and Save stop the watcher instead of causing it to reconnect.
+type fatalError struct {
+	err error
+}
+
+func (e *fatalError) Error() string {
+	return e.err.Error()
+}
+
+func (e *fatalError) Unwrap() error {
+	return e.err
+}
+
+// Watcher streams JackpotJunction events to a handler, reconnecting to the JSON-RPC API and resuming from
+// its checkpoint whenever the connection fails.
+//
+// On each connection, the watcher first catches up from its checkpoint to the current block with eth_getLogs.
+// If Subscribe is true, it then follows new events through a subscription. Otherwise, it polls with
+// eth_getLogs every PollInterval.
+type Watcher struct {
+	Dial     func(ctx context.Context) (Backend, error)
+	Contract common.Address
+	Filter   Filter
+	// Use eth_subscribe (requires a websocket or IPC connection) instead of polling.
+	Subscribe bool
+	// Number of blocks to request logs for in a single eth_getLogs call.
+	ChunkSize     uint64
+	PollInterval  time.Duration
+	RetryInterval time.Duration
+	// Timeout for each request to the JSON-RPC API.
+	Timeout time.Duration
+	// Called for each event, in order.
+	Handle func(event Event) error
+	// Called whenever the checkpoint advances.
+	Save func(checkpoint Checkpoint) error
+	// Called when the watcher loses its connection to the JSON-RPC API, before it reconnects.
+	Disconnected func(err error)
+}
+
+// Run watches events starting from the given checkpoint until the context is cancelled or Handle or Save
+// return an error. If checkpoint.NextBlock is 0, the watcher starts from the current block.
+func (watcher *Watcher) Run(ctx context.Context, checkpoint Checkpoint) error {
+	for {
+		var sessionErr error
+		backend, dialErr := watcher.Dial(ctx)
+		if dialErr != nil {
+			sessionErr = dialErr
+		} else {
+			sessionErr = watcher.session(ctx, backend, &checkpoint)
+			if closer, ok := backend.(interface{ Close() }); ok {
+				closer.Close()
+			}
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		var fatal *fatalError
+		if errors.As(sessionErr, &fatal) {
+			return fatal.err
+		}
+
+		if watcher.Disconnected != nil {
+			watcher.Disconnected(sessionErr)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(watcher.RetryInterval):
+		}
+	}
+}
+
+func (watcher *Watcher) session(ctx context.Context, backend Backend, checkpoint *Checkpoint) error {
+	query, queryErr := watcher.query()
+	if queryErr != nil {
+		return &fatalError{queryErr}
+	}
+
+	if checkpoint.NextBlock == 0 {
+		headCtx, cancel := context.WithTimeout(ctx, watcher.Timeout)
+		head, headErr := backend.BlockNumber(headCtx)
+		cancel()
+		if headErr != nil {
+			return headErr
+		}
+		*checkpoint = Checkpoint{NextBlock: head + 1}
+	}
+
+	if !watcher.Subscribe {
+		for {
+			catchUpErr := watcher.catchUp(ctx, backend, query, checkpoint)
+			if catchUpErr != nil {
+				return catchUpErr
+			}
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(watcher.PollInterval):
+			}
+		}
+	}
+
+	subscriptionCtx, cancelSubscription := context.WithCancel(ctx)
+	defer cancelSubscription()
+
+	// A single subscription covers all the watched events, so that they arrive in the order in which they were
+	// emitted. It is set up before catching up so that no events are missed in between. Events that are
+	// delivered both ways are deduplicated by the checkpoint.
+	logs := make(chan types.Log, 128)
+	subscription, subscribeErr := backend.SubscribeFilterLogs(subscriptionCtx, query, logs)
+	if subscribeErr != nil {
+		return subscribeErr
+	}
+	defer subscription.Unsubscribe()
+
+	catchUpErr := watcher.catchUp(ctx, backend, query, checkpoint)
+	if catchUpErr != nil {
+		return catchUpErr
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case log := <-logs:
+			processErr := watcher.process(log, checkpoint)
+			if processErr != nil {
+				return processErr
+			}
+		case err := <-subscription.Err():
+			return subscriptionError(err)
+		}
+	}
+}
+
+// Returns the query for the logs of the watched events. Events of different types cannot be filtered by their
+// indexed arguments in a single query, so the rest of the filter is applied to the decoded events.
+func (watcher *Watcher) query() (ethereum.FilterQuery, error) {
+	contractABI, abiErr := JackpotJunction.JackpotJunctionMetaData.GetAbi()
+	if abiErr != nil {
+		return ethereum.FilterQuery{}, abiErr
+	}
+
+	eventIDs := []common.Hash{}
+	for _, name := range EventNames {
+		if watcher.Filter.includes(name) {
+			eventIDs = append(eventIDs, contractABI.Events[name].ID)
+		}
+	}
+
+	return ethereum.FilterQuery{Addresses: []common.Address{watcher.Contract}, Topics: [][]common.Hash{eventIDs}}, nil
+}
+
+// Subscriptions close their error channels without sending an error when they are unsubscribed.
+func subscriptionError(err error) error {
+	if err == nil {
+		return errors.New("subscription closed")
+	}
+	return err
+}
+
+// Decodes a single log, passes it to the handler if the filter selects it, and advances the checkpoint past
+// it. Events before the checkpoint have already been handled and are skipped. If the event was removed by a
+// reorg, the checkpoint is moved back to the start of its block so that the events which replace it are not
+// skipped.
+func (watcher *Watcher) process(log types.Log, checkpoint *Checkpoint) error {
+	decoded, decodeErr := JackpotJunction.DecodeLogs(watcher.Contract, []*types.Log{&log})
+	if decodeErr != nil {
+		return decodeErr
+	}
+	if len(decoded) == 0 {
+		return nil
+	}
+
+	e, ok := NewEvent(decoded[0])
+	if !ok || !watcher.Filter.matches(e) {
+		return nil
+	}
+
+	if e.Removed {
+		handleErr := watcher.Handle(e)
+		if handleErr != nil {
+			return &fatalError{handleErr}
+		}
+		if checkpoint.Before(e.BlockNumber, e.LogIndex) {
+			return watcher.save(checkpoint, Checkpoint{NextBlock: e.BlockNumber})
+		}
+		return nil
+	}
+
+	if checkpoint.Before(e.BlockNumber, e.LogIndex) {
+		return nil
+	}
+
+	handleErr := watcher.Handle(e)
+	if handleErr != nil {
+		return &fatalError{handleErr}
+	}
+
+	return watcher.save(checkpoint, Checkpoint{NextBlock: e.BlockNumber, NextLogIndex: e.LogIndex + 1})
+}
+
+func (watcher *Watcher) save(checkpoint *Checkpoint, next Checkpoint) error {
+	*checkpoint = next
+	if watcher.Save != nil {
+		saveErr := watcher.Save(next)
+		if saveErr != nil {
+			return &fatalError{saveErr}
+		}
+	}
+	return nil
+}
+
+// Requests the logs from the checkpoint up to the current block with eth_getLogs and processes them. The logs
+// are returned in the order in which they were emitted.
+func (watcher *Watcher) catchUp(ctx context.Context, backend Backend, query ethereum.FilterQuery, checkpoint *Checkpoint) error {
+	headCtx, cancel := context.WithTimeout(ctx, watcher.Timeout)
+	head, headErr := backend.BlockNumber(headCtx)
+	cancel()
+	if headErr != nil {
+		return headErr
+	}
+
+	chunkSize := watcher.ChunkSize
+	if chunkSize == 0 {
+		chunkSize = 1
+	}
+
+	for checkpoint.NextBlock <= head {
+		fromBlock := checkpoint.NextBlock
+		toBlock := fromBlock + chunkSize - 1
+		if toBlock > head {
+			toBlock = head
+		}
+
+		query.FromBlock = new(big.Int).SetUint64(fromBlock)
+		query.ToBlock = new(big.Int).SetUint64(toBlock)
+		filterCtx, cancel := context.WithTimeout(ctx, watcher.Timeout)
+		logs, logsErr := backend.FilterLogs(filterCtx, query)
+		cancel()
+		if logsErr != nil {
+			return logsErr
+		}
+
+		for _, log := range logs {
+			processErr := watcher.process(log, checkpoint)
+			if processErr != nil {
+				return processErr
+			}
+		}
+
+		saveErr := watcher.save(checkpoint, Checkpoint{NextBlock: toBlock + 1})
+		if saveErr != nil {
+			return saveErr
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package watch
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/moonstream-to/degen-trail/bindings/JackpotJunction"
+)
+
+var contractAddress common.Address = common.HexToAddress("0x5678")
+
+// Stands in for a JSON-RPC API. Each subscription delivers all the logs that match its query, in the order
+// in which they were emitted, once every subscription that was opened before it has delivered its logs. This
+// is how separate subscriptions for each event can interleave.
+type standIn struct {
+	head          uint64
+	logs          []types.Log
+	subscriptions int
+	previous      chan struct{}
+}
+
+func (s *standIn) BlockNumber(ctx context.Context) (uint64, error) {
+	return s.head, nil
+}
+
+func (s *standIn) matching(query ethereum.FilterQuery) []types.Log {
+	matched := []types.Log{}
+	for _, log := range s.logs {
+		if query.FromBlock != nil && log.BlockNumber < query.FromBlock.Uint64() {
+			continue
+		}
+		if query.ToBlock != nil && log.BlockNumber > query.ToBlock.Uint64() {
+			continue
+		}
+		for _, topic := range query.Topics[0] {
+			if log.Topics[0] == topic {
+				matched = append(matched, log)
+				break
+			}
+		}
+	}
+	return matched
+}
+
+func (s *standIn) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return s.matching(query), nil
+}
+
+func (s *standIn) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	s.subscriptions++
+	previous, done := s.previous, make(chan struct{})
+	s.previous = done
+	logs := s.matching(query)
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		if previous != nil {
+			select {
+			case <-previous:
+			case <-quit:
+				return nil
+			}
+		}
+		for _, log := range logs {
+			select {
+			case ch <- log:
+			case <-quit:
+				return nil
+			}
+		}
+		close(done)
+		<-quit
+		return nil
+	}), nil
+}
+
+func newLog(t *testing.T, name string, blockNumber uint64, index uint, indexed []common.Hash, values ...interface{}) types.Log {
+	contractABI, _ := JackpotJunction.JackpotJunctionMetaData.GetAbi()
+	data, packErr := contractABI.Events[name].Inputs.NonIndexed().Pack(values...)
+	if packErr != nil {
+		t.Fatalf("could not pack %s: %s", name, packErr.Error())
+	}
+	return types.Log{
+		Address:     contractAddress,
+		Topics:      append([]common.Hash{contractABI.Events[name].ID}, indexed...),
+		Data:        data,
+		BlockNumber: blockNumber,
+		Index:       index,
+		TxHash:      common.BigToHash(big.NewInt(int64(index + 1))),
+	}
+}
+
+func watch(t *testing.T, backend *standIn, filter Filter, subscribe bool, expected int) []Event {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	handled := []Event{}
+	watcher := Watcher{
+		Dial:         func(ctx context.Context) (Backend, error) { return backend, nil },
+		Contract:     contractAddress,
+		Filter:       filter,
+		Subscribe:    subscribe,
+		ChunkSize:    10,
+		PollInterval: time.Millisecond,
+		Timeout:      time.Second,
+		Handle: func(e Event) error {
+			handled = append(handled, e)
+			if len(handled) == expected {
+				cancel()
+			}
+			return nil
+		},
+	}
+
+	runErr := watcher.Run(ctx, Checkpoint{NextBlock: 5})
+	if len(handled) != expected {
+		t.Fatalf("expected %d events, got %d (%v)", expected, len(handled), runErr)
+	}
+	return handled
+}
+
+func TestSubscriptionKeepsEventsInOrder(t *testing.T) {
+	player := common.HexToHash("0x1234")
+	backend := &standIn{
+		head: 4,
+		logs: []types.Log{
+			newLog(t, EventAward, 5, 0, []common.Hash{player, common.BigToHash(big.NewInt(4))}, big.NewInt(1000)),
+			newLog(t, EventRoll, 5, 1, []common.Hash{player}),
+			newLog(t, EventTierUnlocked, 5, 2, []common.Hash{common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(2)), common.BigToHash(big.NewInt(1))}, big.NewInt(35)),
+			newLog(t, EventAward, 5, 3, []common.Hash{player, common.BigToHash(big.NewInt(0))}, big.NewInt(0)),
+		},
+	}
+
+	handled := watch(t, backend, Filter{}, true, 4)
+	if backend.subscriptions != 1 {
+		t.Errorf("expected a single subscription, got %d", backend.subscriptions)
+	}
+	for i, e := range handled {
+		if e.LogIndex != uint(i) {
+			t.Errorf("expected event %d to have log index %d, got %+v", i, i, e)
+		}
+	}
+	if handled[2].Event != EventTierUnlocked || handled[2].Tier.Int64() != 1 {
+		t.Errorf("expected a tier 1 unlock, got %+v", handled[2])
+	}
+}
+
+func TestFilterAppliedToDecodedEvents(t *testing.T) {
+	player, other := common.HexToAddress("0x1234"), common.HexToAddress("0x9999")
+	backend := &standIn{
+		head: 5,
+		logs: []types.Log{
+			newLog(t, EventRoll, 5, 0, []common.Hash{common.BytesToHash(other.Bytes())}),
+			newLog(t, EventAward, 5, 1, []common.Hash{common.BytesToHash(player.Bytes()), common.BigToHash(big.NewInt(1))}, big.NewInt(35)),
+			newLog(t, EventRoll, 5, 2, []common.Hash{common.BytesToHash(player.Bytes())}),
+			newLog(t, EventAward, 5, 3, []common.Hash{common.BytesToHash(player.Bytes()), common.BigToHash(big.NewInt(4))}, big.NewInt(1000)),
+			newLog(t, EventTierUnlocked, 5, 4, []common.Hash{common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(2)), common.BigToHash(big.NewInt(1))}, big.NewInt(35)),
+		},
+	}
+
+	filter := Filter{Events: []string{EventRoll, EventAward}, Players: []common.Address{player}, Outcomes: []*big.Int{big.NewInt(4)}}
+	handled := watch(t, backend, filter, false, 2)
+	if handled[0].Event != EventRoll || handled[0].LogIndex != 2 {
+		t.Errorf("expected the roll by the player first, got %+v", handled[0])
+	}
+	if handled[1].Event != EventAward || handled[1].Outcome.Int64() != 4 {
+		t.Errorf("expected the jackpot award second, got %+v", handled[1])
+	}
+}
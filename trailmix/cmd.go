@@ -1,10 +1,15 @@
 package main
 
 import (
+	"errors"
 	"os"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/spf13/cobra"
 
+	"github.com/moonstream-to/degen-trail/bindings/DegenTrail"
 	"github.com/moonstream-to/degen-trail/trailmix/game"
 	"github.com/moonstream-to/degen-trail/trailmix/version"
 )
@@ -23,7 +28,10 @@ func CreateRootCommand() *cobra.Command {
 	versionCmd := CreateVersionCommand()
 	boardCmd := CreateBoardCommand()
 	hexCmd := CreateHexCommand()
-	rootCmd.AddCommand(completionCmd, versionCmd, boardCmd, hexCmd)
+	contractCmd := DegenTrail.CreateDegenTrailCommand()
+	contractCmd.Use = "contract"
+	nftCmd := CreateNFTCommand()
+	rootCmd.AddCommand(completionCmd, versionCmd, boardCmd, hexCmd, contractCmd, nftCmd)
 
 	// By default, cobra Command objects write to stderr. We have to forcibly set them to output to
 	// stdout.
@@ -150,29 +158,92 @@ func CreateHexCommand() *cobra.Command {
 }
 
 func CreateBoardCommand() *cobra.Command {
-	var outfile string
-	var strips, hexesPerStrip, strokeRed, strokeGreen, strokeBlue, start uint
+	var outfile, rpc, contractAddressRaw, blockNumberRaw, blockHashRaw string
+	var strips, hexesPerStrip, strokeRed, strokeGreen, strokeBlue, start, timeout uint
+	var row, offset uint64
 	var strokeWidth float32
 	var seed int64
+	var contractAddress common.Address
+	var blockHash common.Hash
 	boardCmd := &cobra.Command{
 		Use:   "board",
 		Short: "View a portion of the game board for The Degen Trail",
-		RunE: func(cmd *cobra.Command, args []string) error {
-			yMultiplier := int(strips/2) + 1
-			if strips%2 == 0 {
-				yMultiplier = int(strips) / 2
+		Long: `View a portion of the game board for The Degen Trail.
+
+By default, this command generates terrain procedurally from --seed. If --contract is specified, it
+instead renders the state of the board on a DegenTrail contract. If --block-hash is specified, it renders
+the board that a DegenTrail contract would start with if it were deployed in the block after the block
+with that hash, using the same algorithm as the contract.
+
+With --contract or --block-hash, it shows --strips rows of the board starting at row --row, with
+--hexes-per-strip hexes in each row starting from hex --offset of the row. Explored hexes are filled with
+the color of their terrain and unexplored hexes are shown as fog.`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if contractAddressRaw != "" && blockHashRaw != "" {
+				return errors.New("only one of --contract and --block-hash may be specified")
 			}
-			preamble, err := game.Preamble((3*float32(hexesPerStrip)*game.Boundary.X+1)/2, float32(yMultiplier)*game.Boundary.Y)
-			if err != nil {
-				return err
+
+			if blockHashRaw != "" {
+				blockHashBytes, decodeErr := hexutil.Decode(blockHashRaw)
+				if decodeErr != nil || len(blockHashBytes) != common.HashLength {
+					return errors.New("--block-hash is not a valid 32 byte hash")
+				}
+				blockHash = common.BytesToHash(blockHashBytes)
 			}
 
-			hex, err := game.HexagonalGrid(seed, strips, hexesPerStrip, start, strokeRed, strokeGreen, strokeBlue, strokeWidth)
-			if err != nil {
-				return err
+			if contractAddressRaw != "" {
+				if !common.IsHexAddress(contractAddressRaw) {
+					return errors.New("--contract is not a valid Ethereum address")
+				}
+				contractAddress = common.HexToAddress(contractAddressRaw)
 			}
 
-			result := preamble + hex + game.SVGEnd
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var result string
+			if contractAddressRaw != "" || blockHashRaw != "" {
+				window := game.BoardWindow(row, uint64(strips), offset, uint64(hexesPerStrip))
+
+				var tiles []game.Tile
+				if blockHashRaw != "" {
+					tiles = game.GeneratedBoard(blockHash, window)
+				} else {
+					var tilesErr error
+					tiles, tilesErr = readBoard(rpc, contractAddress, blockNumberRaw, timeout, window)
+					if tilesErr != nil {
+						return tilesErr
+					}
+				}
+
+				hex, width, height, err := game.TileGrid(tiles, game.DefaultColors, game.FogColor, strokeRed, strokeGreen, strokeBlue, strokeWidth)
+				if err != nil {
+					return err
+				}
+
+				preamble, err := game.Preamble(width, height)
+				if err != nil {
+					return err
+				}
+
+				result = preamble + hex + game.SVGEnd
+			} else {
+				yMultiplier := int(strips/2) + 1
+				if strips%2 == 0 {
+					yMultiplier = int(strips) / 2
+				}
+				preamble, err := game.Preamble((3*float32(hexesPerStrip)*game.Boundary.X+1)/2, float32(yMultiplier)*game.Boundary.Y)
+				if err != nil {
+					return err
+				}
+
+				hex, err := game.HexagonalGrid(seed, strips, hexesPerStrip, start, strokeRed, strokeGreen, strokeBlue, strokeWidth)
+				if err != nil {
+					return err
+				}
+
+				result = preamble + hex + game.SVGEnd
+			}
 
 			if outfile != "" {
 				writeErr := os.WriteFile(outfile, []byte(result), 0644)
@@ -196,6 +267,33 @@ func CreateBoardCommand() *cobra.Command {
 	boardCmd.Flags().UintVarP(&strokeBlue, "stroke-blue", "B", 0, "The blue component of the stroke color")
 	boardCmd.Flags().Float32VarP(&strokeWidth, "stroke-width", "w", 0.1, "The width of the stroke")
 	boardCmd.Flags().UintVar(&start, "start", 0, "The vertical position of the easternmost hexes")
+	boardCmd.Flags().StringVar(&rpc, "rpc", "", "URL of the JSONRPC API to use (with --contract)")
+	boardCmd.Flags().StringVar(&contractAddressRaw, "contract", "", "Address of a DegenTrail contract to read the board from")
+	boardCmd.Flags().StringVar(&blockHashRaw, "block-hash", "", "Hash of the block before the deployment of a DegenTrail contract, to generate its initial board")
+	boardCmd.Flags().StringVar(&blockNumberRaw, "block", "", "Block number at which to read the board (with --contract, defaults to the latest block)")
+	boardCmd.Flags().Uint64Var(&row, "row", 0, "The first row of the board to display (with --contract or --block-hash)")
+	boardCmd.Flags().Uint64Var(&offset, "offset", 0, "The number of hexes to skip at the start of each row (with --contract or --block-hash)")
+	boardCmd.Flags().UintVar(&timeout, "timeout", 60, "Timeout (in seconds) for interactions with the JSONRPC API")
 
 	return boardCmd
 }
+
+// Reads the state of the hexes with the given coordinates from the DegenTrail contract at contractAddress.
+func readBoard(rpc string, contractAddress common.Address, blockNumberRaw string, timeout uint, coordinates [][2]uint64) ([]game.Tile, error) {
+	client, clientErr := DegenTrail.NewClient(rpc)
+	if clientErr != nil {
+		return nil, clientErr
+	}
+
+	contract, contractErr := DegenTrail.NewDegenTrail(contractAddress, client)
+	if contractErr != nil {
+		return nil, contractErr
+	}
+
+	ctx, cancel := DegenTrail.NewChainContext(timeout)
+	defer cancel()
+	callOpts := bind.CallOpts{Context: ctx}
+	DegenTrail.SetCallParametersFromArgs(&callOpts, false, "", blockNumberRaw)
+
+	return game.ReadBoard(&contract.DegenTrailCaller, &callOpts, coordinates)
+}
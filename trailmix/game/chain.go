@@ -0,0 +1,171 @@
+package game
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/moonstream-to/degen-trail/bindings/DegenTrail"
+)
+
+var ErrInvalidHexState error = errors.New("invalid hex state")
+
+// Maximum number of hexes requested in a single call to board on the DegenTrail contract.
+const BoardBatchSize int = 500
+
+// Number of columns on the DegenTrail board. Valid hexes (i, j) have j < BoardColumns and i and j of the
+// same parity.
+const BoardColumns uint64 = 200
+
+// Width and height of the hexes drawn by TileGrid, which are pointy side up with corners 1 away from their
+// centers.
+const (
+	hexWidth  float32 = 1.73205080756
+	hexHeight float32 = 2
+)
+
+// Fill color for hexes that have not been explored.
+var FogColor HexColor = HexColor{Red: 190, Green: 190, Blue: 190, Alpha: 0.4}
+
+// Tile is the state of the hex with coordinates (I, J) on the DegenTrail board, decoded from its TTTE
+// encoding: the least significant bit is set if the hex has been explored, and the next three bits hold its
+// terrain type.
+type Tile struct {
+	I        uint64
+	J        uint64
+	Explored bool
+	Terrain  uint
+}
+
+// DecodeTile decodes the TTTE encoding of the state of the hex (i, j). It returns ErrInvalidHexState if the
+// state does not fit the encoding or if an explored hex has a terrain type outside the palette.
+func DecodeTile(i, j uint64, state *big.Int) (Tile, error) {
+	if state.Sign() < 0 || state.BitLen() > 4 {
+		return Tile{}, ErrInvalidHexState
+	}
+
+	raw := uint(state.Uint64())
+	tile := Tile{I: i, J: j, Explored: raw&1 == 1, Terrain: raw >> 1}
+	if tile.Explored && tile.Terrain >= uint(len(DefaultColors)) {
+		return Tile{}, ErrInvalidHexState
+	}
+	return tile, nil
+}
+
+// BoardWindow lists the coordinates of the hexes in rows firstRow to firstRow+rows-1 of the DegenTrail board.
+// Each row contributes hexesPerRow hexes, skipping the first offset hexes in the row. Hexes beyond the last
+// column of the board are left out.
+func BoardWindow(firstRow, rows, offset, hexesPerRow uint64) [][2]uint64 {
+	window := make([][2]uint64, 0, rows*hexesPerRow)
+	for i := firstRow; i < firstRow+rows; i++ {
+		for k := offset; k < offset+hexesPerRow; k++ {
+			j := 2*k + i%2
+			if j >= BoardColumns {
+				break
+			}
+			window = append(window, [2]uint64{i, j})
+		}
+	}
+	return window
+}
+
+// ReadBoard reads the states of the hexes with the given coordinates from a DegenTrail contract, in batches
+// of at most BoardBatchSize hexes, and decodes them.
+func ReadBoard(caller *DegenTrail.DegenTrailCaller, opts *bind.CallOpts, coordinates [][2]uint64) ([]Tile, error) {
+	tiles := make([]Tile, 0, len(coordinates))
+	for start := 0; start < len(coordinates); start += BoardBatchSize {
+		end := start + BoardBatchSize
+		if end > len(coordinates) {
+			end = len(coordinates)
+		}
+
+		indices := make([][2]*big.Int, end-start)
+		for k, coordinate := range coordinates[start:end] {
+			indices[k] = [2]*big.Int{new(big.Int).SetUint64(coordinate[0]), new(big.Int).SetUint64(coordinate[1])}
+		}
+
+		states, statesErr := caller.Board(opts, indices)
+		if statesErr != nil {
+			return nil, statesErr
+		}
+		if len(states) != len(indices) {
+			return nil, fmt.Errorf("board returned %d states for %d hexes", len(states), len(indices))
+		}
+
+		for _, state := range states {
+			tile, tileErr := DecodeTile(state[0].Uint64(), state[1].Uint64(), state[2])
+			if tileErr != nil {
+				return nil, fmt.Errorf("hex (%s, %s) has state %s: %w", state[0].String(), state[1].String(), state[2].String(), tileErr)
+			}
+			tiles = append(tiles, tile)
+		}
+	}
+	return tiles, nil
+}
+
+// TileGrid renders the given tiles as hexes in SVG. Explored hexes are filled with the color of their terrain
+// type from palette and unexplored hexes are filled with fog. Hexes are placed by their pixel positions, as
+// given by Hex.Corners with a size of 1, shifted so that the hexes with the smallest i and j touch the top and
+// left edges of the image. TileGrid returns the rendered hexes along with the width and height of the area
+// that they cover.
+func TileGrid(tiles []Tile, palette []HexColor, fog HexColor, strokeRed, strokeGreen, strokeBlue uint, strokeWidth float32) (string, float32, float32, error) {
+	if strokeRed > 255 || strokeGreen > 255 || strokeBlue > 255 {
+		return "", 0, 0, ErrInvalidColor
+	}
+
+	if strokeWidth < 0 {
+		return "", 0, 0, ErrInvalidParameter
+	}
+
+	if len(tiles) == 0 {
+		return "", 0, 0, nil
+	}
+
+	minI, minJ, maxI, maxJ := tiles[0].I, tiles[0].J, tiles[0].I, tiles[0].J
+	for _, tile := range tiles {
+		minI, maxI = min(minI, tile.I), max(maxI, tile.I)
+		minJ, maxJ = min(minJ, tile.J), max(maxJ, tile.J)
+	}
+	// Shift from pixel positions on the board to positions in the image.
+	origin := Hex{I: int64(minI), J: int64(minJ)}.Center(1)
+	origin.X -= hexWidth / 2
+	origin.Y -= hexHeight / 2
+
+	params := make([]HexagonParameters, len(tiles))
+	for k, tile := range tiles {
+		colors := fog
+		if tile.Explored {
+			if tile.Terrain >= uint(len(palette)) {
+				return "", 0, 0, ErrInvalidHexState
+			}
+			colors = palette[tile.Terrain]
+		}
+
+		params[k] = HexagonParameters{
+			Fill:        fmt.Sprintf("#%02x%02x%02x", colors.Red, colors.Green, colors.Blue),
+			Opacity:     fmt.Sprintf("%f", colors.Alpha),
+			Stroke:      fmt.Sprintf("#%02x%02x%02x", strokeRed, strokeGreen, strokeBlue),
+			StrokeWidth: fmt.Sprintf("%f", strokeWidth),
+			TerrainType: tile.Terrain,
+		}
+
+		corners := Hex{I: int64(tile.I), J: int64(tile.J)}.Corners(1)
+		pointsStr := make([]string, len(corners))
+		for l, corner := range corners {
+			pointsStr[l] = fmt.Sprintf("%f,%f", corner.X-origin.X, corner.Y-origin.Y)
+		}
+		params[k].Vertices = strings.Join(pointsStr, " ")
+	}
+
+	var b bytes.Buffer
+	err := HexagonTemplate.Execute(&b, params)
+
+	extent := Hex{I: int64(maxI), J: int64(maxJ)}.Center(1)
+	width := extent.X - origin.X + hexWidth/2
+	height := extent.Y - origin.Y + hexHeight/2
+	return b.String(), width, height, err
+}
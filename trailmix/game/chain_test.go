@@ -0,0 +1,69 @@
+package game
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestDecodeTile(t *testing.T) {
+	cases := []struct {
+		state    int64
+		expected Tile
+	}{
+		{0, Tile{I: 4, J: 6}},
+		{1, Tile{I: 4, J: 6, Explored: true, Terrain: 0}},
+		{3, Tile{I: 4, J: 6, Explored: true, Terrain: 1}},
+		{13, Tile{I: 4, J: 6, Explored: true, Terrain: 6}},
+		{12, Tile{I: 4, J: 6, Explored: false, Terrain: 6}},
+	}
+	for _, c := range cases {
+		tile, err := DecodeTile(4, 6, big.NewInt(c.state))
+		if err != nil {
+			t.Errorf("state %d: unexpected error: %s", c.state, err.Error())
+		} else if tile != c.expected {
+			t.Errorf("state %d: expected %+v, got %+v", c.state, c.expected, tile)
+		}
+	}
+
+	for _, state := range []int64{15, 16, -1} {
+		if _, err := DecodeTile(0, 0, big.NewInt(state)); !errors.Is(err, ErrInvalidHexState) {
+			t.Errorf("state %d: expected ErrInvalidHexState, got %v", state, err)
+		}
+	}
+}
+
+func TestBoardWindow(t *testing.T) {
+	window := BoardWindow(3, 2, 1, 2)
+	expected := [][2]uint64{{3, 3}, {3, 5}, {4, 2}, {4, 4}}
+	if len(window) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, window)
+	}
+	for k := range expected {
+		if window[k] != expected[k] {
+			t.Errorf("expected %v, got %v", expected, window)
+			break
+		}
+	}
+
+	// Rows are cut off at the last column of the board.
+	if edge := BoardWindow(1, 1, 98, 5); len(edge) != 2 || edge[1] != [2]uint64{1, 199} {
+		t.Errorf("expected the window to end at hex (1, 199), got %v", edge)
+	}
+}
+
+func TestTileGridFog(t *testing.T) {
+	tiles := []Tile{{I: 0, J: 0, Explored: true, Terrain: 3}, {I: 1, J: 1}}
+	svg, width, height, err := TileGrid(tiles, DefaultColors, FogColor, 0, 0, 0, 0.1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !strings.Contains(svg, `fill="#45d7f5"`) || !strings.Contains(svg, `fill="#bebebe"`) {
+		t.Errorf("expected one water hex and one fog hex, got %s", svg)
+	}
+	if math.Abs(float64(width)-1.5*math.Sqrt(3)) > 1e-4 || math.Abs(float64(height)-3.5) > 1e-4 {
+		t.Errorf("unexpected dimensions %f x %f", width, height)
+	}
+}
@@ -0,0 +1,95 @@
+package game
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Number of hexes in row 0 that the DegenTrail constructor explores: (0, 0), (0, 2), ..., (0, 198).
+const InitialExploredHexes uint64 = 100
+
+// Multiplier for the column index in the entropy that the DegenTrail constructor uses to explore each hex in
+// row 0.
+const InitialEntropyStep int64 = 31
+
+// EnvironmentDistributions are the cumulative distributions of terrain types in each environment, as stored in
+// the EnvironmentDistributions array on the DegenTrail contract. They are the running sums of the rows of
+// Environments.
+var EnvironmentDistributions [7][7]uint = cumulativeDistributions(Environments)
+
+func cumulativeDistributions(environments [7][7]uint) [7][7]uint {
+	var distributions [7][7]uint
+	for i, environment := range environments {
+		var accumulator uint
+		for k, mass := range environment {
+			accumulator += mass
+			distributions[i][k] = accumulator
+		}
+	}
+	return distributions
+}
+
+// Environment returns the environment of the hexes in row i of the board, as computed by environment on the
+// DegenTrail contract.
+func Environment(i uint64) uint {
+	return uint(3 * (i >> 5) % 7)
+}
+
+// ExploreTerrain returns the terrain type that _explore on the DegenTrail contract assigns to a hex in row i
+// when it is explored with the given entropy.
+//
+// The contract compares the low 7 bits of the entropy with the cumulative distribution of the environment
+// starting from its last entry, which is always 128. Every explored hex is therefore ice (terrain type 6),
+// and this function reproduces that.
+func ExploreTerrain(i uint64, entropy *big.Int) uint {
+	distribution := EnvironmentDistributions[Environment(i)]
+	sample := uint(new(big.Int).And(entropy, big.NewInt(0x7F)).Uint64())
+
+	for terrain := len(distribution) - 1; terrain >= 1; terrain-- {
+		if sample < distribution[terrain] {
+			return uint(terrain)
+		}
+	}
+	return 0
+}
+
+// StartingEntropy returns the entropy that the DegenTrail constructor derives from the hash of the block
+// before the one in which the contract was deployed: the hash with its most significant bit cleared.
+func StartingEntropy(blockHash common.Hash) *big.Int {
+	entropy := new(big.Int).SetBytes(blockHash.Bytes())
+	return entropy.SetBit(entropy, 255, 0)
+}
+
+// InitialTiles returns the hexes that the DegenTrail constructor explores, given the hash of the block before
+// the one in which the contract was deployed.
+func InitialTiles(blockHash common.Hash) []Tile {
+	startingEntropy := StartingEntropy(blockHash)
+
+	tiles := make([]Tile, InitialExploredHexes)
+	for j := uint64(0); j < InitialExploredHexes; j++ {
+		entropy := new(big.Int).Add(startingEntropy, big.NewInt(InitialEntropyStep*int64(j)))
+		tiles[j] = Tile{I: 0, J: 2 * j, Explored: true, Terrain: ExploreTerrain(0, entropy)}
+	}
+	return tiles
+}
+
+// GeneratedBoard returns the state of the hexes with the given coordinates on a DegenTrail contract right
+// after it was deployed, given the hash of the block before the one in which it was deployed. Only the hexes
+// that the constructor explores are explored.
+func GeneratedBoard(blockHash common.Hash, coordinates [][2]uint64) []Tile {
+	explored := make(map[[2]uint64]Tile, InitialExploredHexes)
+	for _, tile := range InitialTiles(blockHash) {
+		explored[[2]uint64{tile.I, tile.J}] = tile
+	}
+
+	tiles := make([]Tile, len(coordinates))
+	for k, coordinate := range coordinates {
+		tile, ok := explored[coordinate]
+		if !ok {
+			tile = Tile{I: coordinate[0], J: coordinate[1]}
+		}
+		tiles[k] = tile
+	}
+	return tiles
+}
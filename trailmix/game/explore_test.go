@@ -0,0 +1,68 @@
+package game
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestEnvironmentDistributions(t *testing.T) {
+	// Copied from the EnvironmentDistributions array in src/Game.sol.
+	expected := [7][7]uint{
+		{0, 90, 98, 123, 128, 128, 128},
+		{90, 95, 100, 120, 120, 128, 128},
+		{0, 0, 8, 128, 128, 128, 128},
+		{0, 8, 8, 8, 28, 28, 128},
+		{5, 10, 108, 128, 128, 128, 128},
+		{18, 18, 18, 18, 28, 128, 128},
+		{0, 43, 43, 48, 128, 128, 128},
+	}
+	if EnvironmentDistributions != expected {
+		t.Errorf("expected %v, got %v", expected, EnvironmentDistributions)
+	}
+}
+
+func TestEnvironment(t *testing.T) {
+	cases := map[uint64]uint{0: 0, 31: 0, 32: 3, 64: 6, 96: 2, 224: 0}
+	for i, expected := range cases {
+		if environment := Environment(i); environment != expected {
+			t.Errorf("row %d: expected environment %d, got %d", i, expected, environment)
+		}
+	}
+}
+
+func TestExploreTerrain(t *testing.T) {
+	for i := uint64(0); i < 7*32; i += 32 {
+		for sample := int64(0); sample < 128; sample++ {
+			entropy := new(big.Int).Lsh(big.NewInt(sample+1), 7)
+			entropy.Or(entropy, big.NewInt(sample))
+			if terrain := ExploreTerrain(i, entropy); terrain != 6 {
+				t.Fatalf("row %d, sample %d: expected ice as on the contract, got terrain %d", i, sample, terrain)
+			}
+		}
+	}
+}
+
+func TestInitialTiles(t *testing.T) {
+	blockHash := common.HexToHash("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+	if entropy := StartingEntropy(blockHash); entropy.BitLen() != 255 {
+		t.Errorf("expected the most significant bit of the starting entropy to be cleared, got %s", entropy.Text(16))
+	}
+
+	tiles := InitialTiles(blockHash)
+	if uint64(len(tiles)) != InitialExploredHexes {
+		t.Fatalf("expected %d tiles, got %d", InitialExploredHexes, len(tiles))
+	}
+	for j, tile := range tiles {
+		if tile.I != 0 || tile.J != 2*uint64(j) || !tile.Explored {
+			t.Errorf("unexpected tile %+v at index %d", tile, j)
+		}
+	}
+
+	board := GeneratedBoard(blockHash, BoardWindow(0, 2, 99, 2))
+	expected := []Tile{{I: 0, J: 198, Explored: true, Terrain: tiles[99].Terrain}, {I: 1, J: 199}}
+	if len(board) != len(expected) || board[0] != expected[0] || board[1] != expected[1] {
+		t.Errorf("expected %+v, got %+v", expected, board)
+	}
+}
@@ -0,0 +1,202 @@
+package game
+
+import (
+	"math"
+)
+
+// Hex is the position of a hex on the DegenTrail board, in the (i, j) coordinates used by Game.sol. I is the
+// row and J is the column. Columns are doubled: hexes in the same row are 2 columns apart, and a hex shares
+// edges with hexes in the rows above and below it at 1 column to either side. A position is on the board only
+// if I and J have the same parity and J is less than BoardColumns.
+//
+// Pixel positions place hexes pointy side up, with rows growing downwards and columns growing to the right.
+type Hex struct {
+	I int64
+	J int64
+}
+
+// Axial is the position of a hex in axial coordinates, with Q growing along a row and R growing with the row.
+type Axial struct {
+	Q int64
+	R int64
+}
+
+// Cube is the position of a hex in cube coordinates. Q + R + S is always 0.
+type Cube struct {
+	Q int64
+	R int64
+	S int64
+}
+
+// Offsets from a hex to its neighbors, starting with the hex to its right and going counterclockwise.
+var HexDirections [6]Hex = [6]Hex{
+	{I: 0, J: 2},
+	{I: -1, J: 1},
+	{I: -1, J: -1},
+	{I: 0, J: -2},
+	{I: 1, J: -1},
+	{I: 1, J: 1},
+}
+
+// Valid returns true if the hex is on the board. It matches hexp on the DegenTrail contract, which also
+// requires that neither coordinate is negative.
+func (h Hex) Valid() bool {
+	if h.I < 0 || h.J < 0 || h.J >= int64(BoardColumns) {
+		return false
+	}
+	return (h.I^h.J)&1 == 0
+}
+
+// Add returns the hex at the given offset from h.
+func (h Hex) Add(offset Hex) Hex {
+	return Hex{I: h.I + offset.I, J: h.J + offset.J}
+}
+
+// Neighbors returns the neighbors of h that are on the board, in the order of HexDirections.
+func (h Hex) Neighbors() []Hex {
+	neighbors := make([]Hex, 0, len(HexDirections))
+	for _, direction := range HexDirections {
+		if neighbor := h.Add(direction); neighbor.Valid() {
+			neighbors = append(neighbors, neighbor)
+		}
+	}
+	return neighbors
+}
+
+// IsNeighbor returns true if h and other are both on the board and share an edge. It matches neighborsp on the
+// DegenTrail contract.
+func (h Hex) IsNeighbor(other Hex) bool {
+	return h.Valid() && other.Valid() && Distance(h, other) == 1
+}
+
+// Axial converts h to axial coordinates. I and J must have the same parity.
+func (h Hex) Axial() Axial {
+	return Axial{Q: (h.J - h.I) / 2, R: h.I}
+}
+
+// Hex converts a to (i, j) coordinates.
+func (a Axial) Hex() Hex {
+	return Hex{I: a.R, J: 2*a.Q + a.R}
+}
+
+// Cube converts h to cube coordinates.
+func (h Hex) Cube() Cube {
+	a := h.Axial()
+	return Cube{Q: a.Q, R: a.R, S: -a.Q - a.R}
+}
+
+// Hex converts c to (i, j) coordinates.
+func (c Cube) Hex() Hex {
+	return Axial{Q: c.Q, R: c.R}.Hex()
+}
+
+func abs(x int64) int64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// Distance returns the number of steps between neighbors that it takes to go from a to b.
+func Distance(a, b Hex) int64 {
+	rows := abs(a.I - b.I)
+	columns := abs(a.J - b.J)
+	return rows + max(0, (columns-rows)/2)
+}
+
+// Ring returns the hexes on the board at exactly the given distance from center. It starts from the hex
+// radius steps to the right of center and goes counterclockwise. The ring of radius 0 is center itself.
+func Ring(center Hex, radius int64) []Hex {
+	if radius == 0 {
+		if center.Valid() {
+			return []Hex{center}
+		}
+		return []Hex{}
+	}
+
+	ring := make([]Hex, 0, 6*radius)
+	current := Hex{I: center.I + radius*HexDirections[0].I, J: center.J + radius*HexDirections[0].J}
+	// Going counterclockwise from the rightmost hex, the sides of the ring run up and to the left first.
+	for side := 0; side < 6; side++ {
+		direction := HexDirections[(side+2)%6]
+		for step := int64(0); step < radius; step++ {
+			if current.Valid() {
+				ring = append(ring, current)
+			}
+			current = current.Add(direction)
+		}
+	}
+	return ring
+}
+
+// Range returns the hexes on the board within the given distance of center, ring by ring from center
+// outwards.
+func Range(center Hex, radius int64) []Hex {
+	hexes := []Hex{}
+	for r := int64(0); r <= radius; r++ {
+		hexes = append(hexes, Ring(center, r)...)
+	}
+	return hexes
+}
+
+// Rounds fractional cube coordinates to the cube coordinates of the hex that contains them.
+func roundCube(q, r, s float64) Cube {
+	roundedQ, roundedR, roundedS := math.Round(q), math.Round(r), math.Round(s)
+	dq, dr, ds := math.Abs(roundedQ-q), math.Abs(roundedR-r), math.Abs(roundedS-s)
+
+	if dq > dr && dq > ds {
+		roundedQ = -roundedR - roundedS
+	} else if dr > ds {
+		roundedR = -roundedQ - roundedS
+	} else {
+		roundedS = -roundedQ - roundedR
+	}
+	return Cube{Q: int64(roundedQ), R: int64(roundedR), S: int64(roundedS)}
+}
+
+// Line returns the hexes crossed by the straight line between the centers of a and b, including a and b,
+// with consecutive hexes being neighbors. Where the line runs along an edge between two hexes, it takes the
+// same side of the edge throughout.
+func Line(a, b Hex) []Hex {
+	n := Distance(a, b)
+	start, end := a.Cube(), b.Cube()
+
+	// Nudging the endpoints keeps points on edges from rounding inconsistently.
+	const epsilon float64 = 1e-6
+	startQ, startR, startS := float64(start.Q)+epsilon, float64(start.R)+epsilon, float64(start.S)-2*epsilon
+	endQ, endR, endS := float64(end.Q)+epsilon, float64(end.R)+epsilon, float64(end.S)-2*epsilon
+
+	line := make([]Hex, n+1)
+	for k := int64(0); k <= n; k++ {
+		t := 0.0
+		if n > 0 {
+			t = float64(k) / float64(n)
+		}
+		line[k] = roundCube(startQ+(endQ-startQ)*t, startR+(endR-startR)*t, startS+(endS-startS)*t).Hex()
+	}
+	return line
+}
+
+// Center returns the pixel position of the center of h, for hexes whose corners are size away from their
+// centers. The center of the hex (0, 0) is at the origin.
+func (h Hex) Center(size float32) Coordinates {
+	return Coordinates{
+		X: size * float32(math.Sqrt(3)/2) * float32(h.J),
+		Y: size * 1.5 * float32(h.I),
+	}
+}
+
+// Corners returns the pixel positions of the corners of h, for hexes whose corners are size away from their
+// centers. It starts from the top corner and goes clockwise.
+func (h Hex) Corners(size float32) [6]Coordinates {
+	center := h.Center(size)
+	var corners [6]Coordinates
+	for k := 0; k < 6; k++ {
+		angle := math.Pi * float64(60*k-90) / 180
+		corners[k] = Coordinates{
+			X: center.X + size*float32(math.Cos(angle)),
+			Y: center.Y + size*float32(math.Sin(angle)),
+		}
+	}
+	return corners
+}
@@ -0,0 +1,180 @@
+package game
+
+import (
+	"math"
+	"testing"
+)
+
+// Port of neighborsp from src/Game.sol.
+func neighborsp(i1, j1, i2, j2 int64) bool {
+	if !(Hex{I: i1, J: j1}).Valid() || !(Hex{I: i2, J: j2}).Valid() {
+		return false
+	}
+	if i1 == i2 {
+		return abs(j1-j2) == 2
+	}
+	return abs(i1-i2) == 1 && abs(j1-j2) == 1
+}
+
+func TestHexValid(t *testing.T) {
+	cases := map[Hex]bool{
+		{I: 0, J: 0}:    true,
+		{I: 1, J: 1}:    true,
+		{I: 0, J: 1}:    false,
+		{I: 3, J: 199}:  true,
+		{I: 4, J: 200}:  false,
+		{I: -1, J: 1}:   false,
+		{I: 0, J: -2}:   false,
+		{I: 100, J: 98}: true,
+	}
+	for h, expected := range cases {
+		if h.Valid() != expected {
+			t.Errorf("expected Valid() of %+v to be %t", h, expected)
+		}
+	}
+}
+
+func TestHexNeighbors(t *testing.T) {
+	for i := int64(0); i < 6; i++ {
+		for j := int64(0); j < 8; j++ {
+			for di := int64(-3); di <= 3; di++ {
+				for dj := int64(-4); dj <= 4; dj++ {
+					h, other := Hex{I: i, J: j}, Hex{I: i + di, J: j + dj}
+					if h.IsNeighbor(other) != neighborsp(h.I, h.J, other.I, other.J) {
+						t.Errorf("IsNeighbor(%+v, %+v) does not match neighborsp", h, other)
+					}
+				}
+			}
+
+			h := Hex{I: i, J: j}
+			if !h.Valid() {
+				continue
+			}
+			for _, neighbor := range h.Neighbors() {
+				if !neighborsp(h.I, h.J, neighbor.I, neighbor.J) {
+					t.Errorf("%+v is not a neighbor of %+v", neighbor, h)
+				}
+			}
+		}
+	}
+
+	if neighbors := (Hex{I: 2, J: 2}).Neighbors(); len(neighbors) != 6 {
+		t.Errorf("expected an interior hex to have 6 neighbors, got %v", neighbors)
+	}
+	if neighbors := (Hex{I: 0, J: 0}).Neighbors(); len(neighbors) != 2 {
+		t.Errorf("expected a corner hex to have 2 neighbors, got %v", neighbors)
+	}
+}
+
+func TestHexConversions(t *testing.T) {
+	for i := int64(-5); i <= 5; i++ {
+		for j := int64(-10); j <= 10; j++ {
+			if (i^j)&1 != 0 {
+				continue
+			}
+			h := Hex{I: i, J: j}
+			cube := h.Cube()
+			if cube.Q+cube.R+cube.S != 0 {
+				t.Errorf("cube coordinates %+v of %+v do not sum to 0", cube, h)
+			}
+			if cube.Hex() != h || h.Axial().Hex() != h {
+				t.Errorf("conversions of %+v do not round trip", h)
+			}
+		}
+	}
+
+	// Moving in any direction changes the cube coordinates by a unit vector.
+	origin := Hex{I: 4, J: 4}.Cube()
+	for _, direction := range HexDirections {
+		cube := Hex{I: 4, J: 4}.Add(direction).Cube()
+		if abs(cube.Q-origin.Q)+abs(cube.R-origin.R)+abs(cube.S-origin.S) != 2 {
+			t.Errorf("direction %+v is not a unit step in cube coordinates", direction)
+		}
+	}
+}
+
+func TestDistanceRingsAndRanges(t *testing.T) {
+	center := Hex{I: 10, J: 20}
+	if d := Distance(center, Hex{I: 10, J: 26}); d != 3 {
+		t.Errorf("expected distance 3 along a row, got %d", d)
+	}
+	if d := Distance(center, Hex{I: 13, J: 23}); d != 3 {
+		t.Errorf("expected distance 3 along a diagonal, got %d", d)
+	}
+	if d := Distance(center, Hex{I: 14, J: 20}); d != 4 {
+		t.Errorf("expected distance 4 straight down, got %d", d)
+	}
+
+	for radius := int64(0); radius <= 4; radius++ {
+		ring := Ring(center, radius)
+		expected := 6 * radius
+		if radius == 0 {
+			expected = 1
+		}
+		if int64(len(ring)) != expected {
+			t.Errorf("expected %d hexes in the ring of radius %d, got %d", expected, radius, len(ring))
+		}
+		for k, h := range ring {
+			if Distance(center, h) != radius {
+				t.Errorf("%+v in the ring of radius %d is at distance %d", h, radius, Distance(center, h))
+			}
+			if k > 0 && !ring[k-1].IsNeighbor(h) {
+				t.Errorf("consecutive hexes %+v and %+v in the ring of radius %d are not neighbors", ring[k-1], h, radius)
+			}
+		}
+	}
+
+	if hexes := Range(center, 3); len(hexes) != 37 {
+		t.Errorf("expected 37 hexes within distance 3, got %d", len(hexes))
+	}
+	// Near the edge of the board, only the hexes on the board are included.
+	if hexes := Range(Hex{I: 0, J: 0}, 1); len(hexes) != 3 {
+		t.Errorf("expected 3 hexes within distance 1 of the corner, got %v", hexes)
+	}
+}
+
+func TestLine(t *testing.T) {
+	cases := []struct{ a, b Hex }{
+		{Hex{I: 0, J: 0}, Hex{I: 0, J: 10}},
+		{Hex{I: 0, J: 0}, Hex{I: 5, J: 5}},
+		{Hex{I: 2, J: 4}, Hex{I: 9, J: 1}},
+		{Hex{I: 7, J: 7}, Hex{I: 7, J: 7}},
+	}
+	for _, c := range cases {
+		line := Line(c.a, c.b)
+		if int64(len(line)) != Distance(c.a, c.b)+1 {
+			t.Errorf("expected %d hexes in the line from %+v to %+v, got %v", Distance(c.a, c.b)+1, c.a, c.b, line)
+			continue
+		}
+		if line[0] != c.a || line[len(line)-1] != c.b {
+			t.Errorf("line from %+v to %+v does not start and end at its endpoints: %v", c.a, c.b, line)
+		}
+		for k := 1; k < len(line); k++ {
+			if Distance(line[k-1], line[k]) != 1 {
+				t.Errorf("consecutive hexes %+v and %+v in the line from %+v to %+v are not neighbors", line[k-1], line[k], c.a, c.b)
+			}
+		}
+	}
+}
+
+func TestPixelPositions(t *testing.T) {
+	// Neighbors have centers sqrt(3) apart for hexes of size 1.
+	h := Hex{I: 3, J: 5}
+	center := h.Center(1)
+	for _, neighbor := range h.Neighbors() {
+		other := neighbor.Center(1)
+		if d := math.Hypot(float64(other.X-center.X), float64(other.Y-center.Y)); math.Abs(d-math.Sqrt(3)) > 1e-4 {
+			t.Errorf("centers of %+v and %+v are %f apart", h, neighbor, d)
+		}
+	}
+
+	corners := (Hex{}).Corners(2)
+	if math.Abs(float64(corners[0].X)) > 1e-4 || math.Abs(float64(corners[0].Y)+2) > 1e-4 {
+		t.Errorf("expected the first corner to be the top corner, got %+v", corners[0])
+	}
+	for _, corner := range corners {
+		if d := math.Hypot(float64(corner.X), float64(corner.Y)); math.Abs(d-2) > 1e-4 {
+			t.Errorf("corner %+v is %f away from the center", corner, d)
+		}
+	}
+}
@@ -0,0 +1,272 @@
+package main
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/moonstream-to/degen-trail/bindings/DegenTrailNFT"
+)
+
+func CreateNFTCommand() *cobra.Command {
+	nftCmd := &cobra.Command{
+		Use:   "nft",
+		Short: "Inspect Degen Trail NFTs",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	tokensCmd := CreateNFTTokensCommand()
+	statsCmd := CreateNFTStatsCommand()
+	metadataCmd := CreateNFTMetadataCommand()
+	contractCmd := DegenTrailNFT.CreateDegenTrailNFTCommand()
+	contractCmd.Use = "contract"
+	nftCmd.AddCommand(tokensCmd, statsCmd, metadataCmd, contractCmd)
+
+	return nftCmd
+}
+
+func printStats(cmd *cobra.Command, stats DegenTrailNFT.DegenTrailStats) {
+	cmd.Printf("Kind: %d\nSpeed: %d\nFight: %d\nRepair: %d\nRecovery: %d\n", stats.Kind, stats.Speed, stats.Fight, stats.Repair, stats.Recovery)
+}
+
+func parseContractAddress(contractAddressRaw string) (common.Address, error) {
+	if contractAddressRaw == "" {
+		return common.Address{}, errors.New("--contract is required")
+	} else if !common.IsHexAddress(contractAddressRaw) {
+		return common.Address{}, errors.New("--contract is not a valid Ethereum address")
+	}
+	return common.HexToAddress(contractAddressRaw), nil
+}
+
+func parseTokenID(tokenIDRaw string) (*big.Int, error) {
+	if tokenIDRaw == "" {
+		return nil, errors.New("--token-id is required")
+	}
+	tokenID, ok := new(big.Int).SetString(tokenIDRaw, 0)
+	if !ok || tokenID.Sign() < 0 {
+		return nil, errors.New("--token-id is not a valid token ID")
+	}
+	return tokenID, nil
+}
+
+func CreateNFTTokensCommand() *cobra.Command {
+	var rpc, contractAddressRaw, ownerRaw, blockNumberRaw string
+	var contractAddress, owner common.Address
+	var timeout uint
+
+	tokensCmd := &cobra.Command{
+		Use:   "tokens",
+		Short: "Show the tokens held by an owner, with their stats and metadata",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			var contractAddressErr error
+			contractAddress, contractAddressErr = parseContractAddress(contractAddressRaw)
+			if contractAddressErr != nil {
+				return contractAddressErr
+			}
+
+			if ownerRaw == "" {
+				return errors.New("--owner is required")
+			} else if !common.IsHexAddress(ownerRaw) {
+				return errors.New("--owner is not a valid Ethereum address")
+			}
+			owner = common.HexToAddress(ownerRaw)
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, clientErr := DegenTrailNFT.NewClient(rpc)
+			if clientErr != nil {
+				return clientErr
+			}
+
+			contract, contractErr := DegenTrailNFT.NewDegenTrailNFT(contractAddress, client)
+			if contractErr != nil {
+				return contractErr
+			}
+
+			ctx, cancel := DegenTrailNFT.NewChainContext(timeout)
+			defer cancel()
+			callOpts := bind.CallOpts{Context: ctx}
+			DegenTrailNFT.SetCallParametersFromArgs(&callOpts, false, "", blockNumberRaw)
+
+			tokenIDs, tokenIDsErr := DegenTrailNFT.ReadOwnerTokens(&contract.DegenTrailNFTCaller, &callOpts, owner)
+			if tokenIDsErr != nil {
+				return tokenIDsErr
+			}
+
+			cmd.Printf("Tokens: %d\n", len(tokenIDs))
+			for _, tokenID := range tokenIDs {
+				stats, statsErr := DegenTrailNFT.ReadStats(&contract.DegenTrailNFTCaller, &callOpts, tokenID)
+				if statsErr != nil {
+					return statsErr
+				}
+				metadata, metadataErr := contract.MetadataJSON(&callOpts, tokenID)
+				if metadataErr != nil {
+					return metadataErr
+				}
+
+				cmd.Printf("\nToken ID: %s\n", tokenID.String())
+				printStats(cmd, stats)
+				cmd.Printf("Metadata: %q\n", metadata)
+			}
+
+			return nil
+		},
+	}
+
+	tokensCmd.Flags().StringVar(&rpc, "rpc", "", "URL of the JSONRPC API to use")
+	tokensCmd.Flags().StringVar(&contractAddressRaw, "contract", "", "Address of the DegenTrailNFT contract")
+	tokensCmd.Flags().StringVar(&ownerRaw, "owner", "", "Address of the owner whose tokens to show")
+	tokensCmd.Flags().StringVar(&blockNumberRaw, "block", "", "Block number at which to read the tokens (defaults to the latest block)")
+	tokensCmd.Flags().UintVar(&timeout, "timeout", 60, "Timeout (in seconds) for interactions with the JSONRPC API")
+
+	return tokensCmd
+}
+
+func CreateNFTStatsCommand() *cobra.Command {
+	var rpc, contractAddressRaw, tokenIDRaw, packedRaw, blockNumberRaw string
+	var contractAddress common.Address
+	var tokenID, packed *big.Int
+	var timeout uint
+
+	statsCmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show the stats of a token, or decode packed stats",
+		Long: `Show the stats of a token, or decode packed stats.
+
+With --token-id, this command reads the stats of the token from the DegenTrailNFT contract and also shows
+them in the packed layout. With --packed, it decodes stats from the packed layout without reading from
+the chain.
+
+The packed layout holds, from most significant to least significant bits: kind (40 bits), then speed,
+fight, repair and recovery (54 bits each).`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if packedRaw != "" {
+				if tokenIDRaw != "" {
+					return errors.New("only one of --token-id and --packed may be specified")
+				}
+				var ok bool
+				packed, ok = new(big.Int).SetString(packedRaw, 0)
+				if !ok || packed.Sign() < 0 || packed.BitLen() > 256 {
+					return errors.New("--packed is not a valid uint256")
+				}
+				return nil
+			}
+
+			var contractAddressErr, tokenIDErr error
+			contractAddress, contractAddressErr = parseContractAddress(contractAddressRaw)
+			if contractAddressErr != nil {
+				return contractAddressErr
+			}
+			tokenID, tokenIDErr = parseTokenID(tokenIDRaw)
+			return tokenIDErr
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if packed != nil {
+				printStats(cmd, DegenTrailNFT.Decode(packed))
+				return nil
+			}
+
+			client, clientErr := DegenTrailNFT.NewClient(rpc)
+			if clientErr != nil {
+				return clientErr
+			}
+
+			contract, contractErr := DegenTrailNFT.NewDegenTrailNFT(contractAddress, client)
+			if contractErr != nil {
+				return contractErr
+			}
+
+			ctx, cancel := DegenTrailNFT.NewChainContext(timeout)
+			defer cancel()
+			callOpts := bind.CallOpts{Context: ctx}
+			DegenTrailNFT.SetCallParametersFromArgs(&callOpts, false, "", blockNumberRaw)
+
+			stats, statsErr := DegenTrailNFT.ReadStats(&contract.DegenTrailNFTCaller, &callOpts, tokenID)
+			if statsErr != nil {
+				return statsErr
+			}
+			encoded, encodeErr := stats.Encode()
+			if encodeErr != nil {
+				return encodeErr
+			}
+
+			printStats(cmd, stats)
+			cmd.Printf("Packed: 0x%064x\n", encoded)
+
+			return nil
+		},
+	}
+
+	statsCmd.Flags().StringVar(&rpc, "rpc", "", "URL of the JSONRPC API to use")
+	statsCmd.Flags().StringVar(&contractAddressRaw, "contract", "", "Address of the DegenTrailNFT contract")
+	statsCmd.Flags().StringVar(&tokenIDRaw, "token-id", "", "ID of the token whose stats to show")
+	statsCmd.Flags().StringVar(&packedRaw, "packed", "", "Packed stats to decode (decimal, or hexadecimal with a 0x prefix)")
+	statsCmd.Flags().StringVar(&blockNumberRaw, "block", "", "Block number at which to read the stats (defaults to the latest block)")
+	statsCmd.Flags().UintVar(&timeout, "timeout", 60, "Timeout (in seconds) for interactions with the JSONRPC API")
+
+	return statsCmd
+}
+
+func CreateNFTMetadataCommand() *cobra.Command {
+	var rpc, contractAddressRaw, tokenIDRaw, blockNumberRaw string
+	var contractAddress common.Address
+	var tokenID *big.Int
+	var timeout uint
+
+	metadataCmd := &cobra.Command{
+		Use:   "metadata",
+		Short: "Show the metadataJSON of a token",
+		Long: `Show the metadataJSON of a token.
+
+The base DegenTrailNFT contract encodes the name and stats in its metadata as raw 32 byte values, so the
+metadata is printed as a quoted Go string with non-printable bytes escaped.`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			var contractAddressErr, tokenIDErr error
+			contractAddress, contractAddressErr = parseContractAddress(contractAddressRaw)
+			if contractAddressErr != nil {
+				return contractAddressErr
+			}
+			tokenID, tokenIDErr = parseTokenID(tokenIDRaw)
+			return tokenIDErr
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, clientErr := DegenTrailNFT.NewClient(rpc)
+			if clientErr != nil {
+				return clientErr
+			}
+
+			contract, contractErr := DegenTrailNFT.NewDegenTrailNFT(contractAddress, client)
+			if contractErr != nil {
+				return contractErr
+			}
+
+			ctx, cancel := DegenTrailNFT.NewChainContext(timeout)
+			defer cancel()
+			callOpts := bind.CallOpts{Context: ctx}
+			DegenTrailNFT.SetCallParametersFromArgs(&callOpts, false, "", blockNumberRaw)
+
+			metadata, metadataErr := contract.MetadataJSON(&callOpts, tokenID)
+			if metadataErr != nil {
+				return metadataErr
+			}
+
+			cmd.Printf("%q\n", metadata)
+
+			return nil
+		},
+	}
+
+	metadataCmd.Flags().StringVar(&rpc, "rpc", "", "URL of the JSONRPC API to use")
+	metadataCmd.Flags().StringVar(&contractAddressRaw, "contract", "", "Address of the DegenTrailNFT contract")
+	metadataCmd.Flags().StringVar(&tokenIDRaw, "token-id", "", "ID of the token whose metadata to show")
+	metadataCmd.Flags().StringVar(&blockNumberRaw, "block", "", "Block number at which to read the metadata (defaults to the latest block)")
+	metadataCmd.Flags().UintVar(&timeout, "timeout", 60, "Timeout (in seconds) for interactions with the JSONRPC API")
+
+	return metadataCmd
+}